@@ -0,0 +1,71 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command matrix merges the JSON summaries written by the report command for
+// several clients into a compatibility matrix. Sample usage:
+//
+//	go run ./cmd/matrix -format=html -out=matrix.html java.json go.json cpp.json nodejs.json
+package main
+
+import (
+	"flag"
+	"io"
+	"log"
+	"os"
+
+	"github.com/googleapis/cloud-bigtable-clients-test/report"
+)
+
+var format = flag.String("format", "markdown", "The format of the matrix, \"markdown\" or \"html\".")
+var out = flag.String("out", "", "The file to write the matrix to. Default to the standard output.")
+
+func main() {
+	flag.Parse()
+	if flag.NArg() == 0 {
+		log.Fatal("No summary file is given, exiting now")
+	}
+
+	var summaries []*report.Summary
+	for _, file := range flag.Args() {
+		summary, err := report.ReadSummary(file)
+		if err != nil {
+			log.Fatalf("Failed to read the summary: %v", err)
+		}
+		summaries = append(summaries, summary)
+	}
+	matrix := report.NewMatrix(summaries)
+
+	var write func(io.Writer) error
+	switch *format {
+	case "markdown":
+		write = matrix.WriteMarkdown
+	case "html":
+		write = matrix.WriteHTML
+	default:
+		log.Fatalf("Unknown format %q, exiting now", *format)
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Failed to create the output: %v", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := write(w); err != nil {
+		log.Fatalf("Failed to write the matrix: %v", err)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command report converts the output of `go test -json` on the test cases into
+// a JUnit XML report and a JSON summary. Sample usage:
+//
+//	go test -json -proxy_addr=:9999 | go run ../cmd/report -client=java -junit=java.xml -summary=java.json
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/googleapis/cloud-bigtable-clients-test/report"
+)
+
+var input = flag.String("input", "",
+	"The file of the `go test -json` output. Default to the standard input.")
+var client = flag.String("client", "client",
+	"The name of the client under test, e.g., \"java\", used as the test suite name and the "+
+		"column of the compatibility matrix.")
+var junitFile = flag.String("junit", "", "If set, the JUnit XML report will be written to the file.")
+var summaryFile = flag.String("summary", "", "If set, the JSON summary will be written to the file.")
+
+func main() {
+	flag.Parse()
+
+	var r io.Reader = os.Stdin
+	if *input != "" {
+		f, err := os.Open(*input)
+		if err != nil {
+			log.Fatalf("Failed to open the input: %v", err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	summary, err := report.Parse(r, *client)
+	if err != nil {
+		log.Fatalf("Failed to parse the input: %v", err)
+	}
+	if *junitFile != "" {
+		if err := writeFile(*junitFile, summary.WriteJUnit); err != nil {
+			log.Fatalf("Failed to write the JUnit report: %v", err)
+		}
+	}
+	if *summaryFile != "" {
+		if err := writeFile(*summaryFile, summary.WriteJSON); err != nil {
+			log.Fatalf("Failed to write the summary: %v", err)
+		}
+	}
+	fmt.Printf("%s: %d passed, %d failed, %d skipped\n", *client,
+		summary.Count(report.Pass), summary.Count(report.Fail), summary.Count(report.Skip))
+}
+
+// writeFile creates `file` and writes it with `write`.
+func writeFile(file string, write func(io.Writer) error) error {
+	f, err := os.Create(file)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
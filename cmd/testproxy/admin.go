@@ -0,0 +1,137 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file implements the table admin methods with bigtable.AdminClient. The
+// methods that the AdminClient has no counterpart for, i.e., modifying several
+// column families in one call and the consistency checks outside of
+// WaitForReplication(), are reported as Unimplemented rather than emulated, so
+// that the tests check the client rather than the proxy.
+package main
+
+import (
+	"context"
+	"strings"
+
+	"cloud.google.com/go/bigtable"
+	adminpb "cloud.google.com/go/bigtable/admin/apiv2/adminpb"
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// adminTableID returns the table ID of the table name `name`, which must be in the instance of
+// the client.
+func (c *testClient) adminTableID(name string) (string, error) {
+	id, ok := strings.CutPrefix(name, c.instanceName+"/tables/")
+	if !ok || id == "" {
+		return "", status.Errorf(codes.InvalidArgument, "table %q is not in the instance %q of the client", name, c.instanceName)
+	}
+	return id, nil
+}
+
+// CreateTable creates a table with its column families and initial splits. The AdminClient
+// doesn't return the created table, so the result only has its name.
+func (s *proxyServer) CreateTable(ctx context.Context, req *testproxypb.CreateTableRequest) (*testproxypb.TableResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	rrq := req.GetRequest()
+	if rrq.GetParent() != c.instanceName {
+		return nil, status.Errorf(codes.InvalidArgument, "parent %q is not the instance %q of the client", rrq.GetParent(), c.instanceName)
+	}
+	table := rrq.GetTable()
+	if table != nil && !proto.Equal(table, &adminpb.Table{ColumnFamilies: table.GetColumnFamilies()}) {
+		return nil, status.Error(codes.Unimplemented, "the proxy only maps the column families of the table to the Go client")
+	}
+	conf := &bigtable.TableConf{TableID: rrq.GetTableId(), ColumnFamilies: make(map[string]bigtable.Family)}
+	for name, family := range table.GetColumnFamilies() {
+		if family.GetValueType() != nil {
+			return nil, status.Error(codes.Unimplemented, "the proxy doesn't map the value types of column families to the Go client")
+		}
+		policy, err := gcPolicyFromProto(family.GetGcRule())
+		if err != nil {
+			return nil, err
+		}
+		conf.ColumnFamilies[name] = bigtable.Family{GCPolicy: policy}
+	}
+	for _, split := range rrq.GetInitialSplits() {
+		conf.SplitKeys = append(conf.SplitKeys, string(split.GetKey()))
+	}
+
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	if err := c.admin.CreateTableFromConf(ctx, conf); err != nil {
+		return &testproxypb.TableResult{Status: statusFromError(err)}, nil
+	}
+	return &testproxypb.TableResult{
+		Status: statusFromError(nil),
+		Table:  &adminpb.Table{Name: rrq.GetParent() + "/tables/" + rrq.GetTableId()},
+	}, nil
+}
+
+// DeleteTable deletes a table.
+func (s *proxyServer) DeleteTable(ctx context.Context, req *testproxypb.DeleteTableRequest) (*testproxypb.DeleteTableResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.adminTableID(req.GetRequest().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	err = c.admin.DeleteTable(ctx, id)
+	return &testproxypb.DeleteTableResult{Status: statusFromError(err)}, nil
+}
+
+// ModifyColumnFamilies is unimplemented, as the AdminClient modifies a single column family per
+// call, and doesn't return the table.
+func (s *proxyServer) ModifyColumnFamilies(ctx context.Context, req *testproxypb.ModifyColumnFamiliesRequest) (*testproxypb.TableResult, error) {
+	return nil, status.Error(codes.Unimplemented, "the Go client modifies a single column family per call")
+}
+
+// DropRowRange drops the rows of a table with a row key prefix, or all of them.
+func (s *proxyServer) DropRowRange(ctx context.Context, req *testproxypb.DropRowRangeRequest) (*testproxypb.DropRowRangeResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	id, err := c.adminTableID(req.GetRequest().GetName())
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+	if req.GetRequest().GetDeleteAllDataFromTable() {
+		err = c.admin.DropAllRows(ctx, id)
+	} else {
+		err = c.admin.DropRowRange(ctx, id, string(req.GetRequest().GetRowKeyPrefix()))
+	}
+	return &testproxypb.DropRowRangeResult{Status: statusFromError(err)}, nil
+}
+
+// GenerateConsistencyToken is unimplemented, as the AdminClient only generates a token within
+// WaitForReplication().
+func (s *proxyServer) GenerateConsistencyToken(ctx context.Context, req *testproxypb.GenerateConsistencyTokenRequest) (*testproxypb.GenerateConsistencyTokenResult, error) {
+	return nil, status.Error(codes.Unimplemented, "the Go client only generates a consistency token within WaitForReplication()")
+}
+
+// CheckConsistency is unimplemented, as the AdminClient only checks a token within
+// WaitForReplication().
+func (s *proxyServer) CheckConsistency(ctx context.Context, req *testproxypb.CheckConsistencyRequest) (*testproxypb.CheckConsistencyResult, error) {
+	return nil, status.Error(codes.Unimplemented, "the Go client only checks a consistency token within WaitForReplication()")
+}
@@ -0,0 +1,50 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file implements the mutation batcher methods. The Go client has no
+// batcher, and a batcher of the proxy's own would test the proxy rather than
+// the client, so they are reported as Unimplemented, and the
+// TestMutationBatcher_* tests are skipped for Go.
+package main
+
+import (
+	"context"
+
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errNoBatcher is returned by the batcher methods.
+var errNoBatcher = status.Error(codes.Unimplemented, "the Go client has no mutation batcher")
+
+// CreateBatcher is unimplemented, as the Go client has no mutation batcher.
+func (s *proxyServer) CreateBatcher(ctx context.Context, req *testproxypb.CreateBatcherRequest) (*testproxypb.CreateBatcherResponse, error) {
+	return nil, errNoBatcher
+}
+
+// AddBatcherEntries is unimplemented, as the Go client has no mutation batcher.
+func (s *proxyServer) AddBatcherEntries(ctx context.Context, req *testproxypb.AddBatcherEntriesRequest) (*testproxypb.AddBatcherEntriesResult, error) {
+	return nil, errNoBatcher
+}
+
+// FlushBatcher is unimplemented, as the Go client has no mutation batcher.
+func (s *proxyServer) FlushBatcher(ctx context.Context, req *testproxypb.FlushBatcherRequest) (*testproxypb.BatcherResult, error) {
+	return nil, errNoBatcher
+}
+
+// CloseBatcher is unimplemented, as the Go client has no mutation batcher.
+func (s *proxyServer) CloseBatcher(ctx context.Context, req *testproxypb.CloseBatcherRequest) (*testproxypb.BatcherResult, error) {
+	return nil, errNoBatcher
+}
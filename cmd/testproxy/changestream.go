@@ -0,0 +1,32 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file implements ReadChangeStream. The Go client has no change stream
+// API, and a decoder of the proxy's own would test the proxy rather than the
+// client, so it's reported as Unimplemented, and the TestReadChangeStream_*
+// tests are skipped for Go.
+package main
+
+import (
+	"context"
+
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ReadChangeStream is unimplemented, as the Go client has no change stream API.
+func (s *proxyServer) ReadChangeStream(ctx context.Context, req *testproxypb.ReadChangeStreamRequest) (*testproxypb.ReadChangeStreamResult, error) {
+	return nil, status.Error(codes.Unimplemented, "the Go client has no change stream API")
+}
@@ -0,0 +1,286 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file converts between the protos of the proxy API and the types of the
+// Go client. The Go client only has closed-open ranges for the row ranges,
+// column ranges and value ranges, so the other bounds are converted with the
+// successor key: an open start "k" is the closed start "k\x00", and a closed
+// end "k" is the open end "k\x00".
+package main
+
+import (
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/bigtable"
+	adminpb "cloud.google.com/go/bigtable/admin/apiv2/adminpb"
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// successor returns the smallest key after `key`.
+func successor(key []byte) []byte {
+	return append(append([]byte{}, key...), 0)
+}
+
+// rowToProto converts a row of the Go client. The Go client keeps the cells of a family in the
+// order of the response, but not the order of the families, so the families are sorted by name.
+func rowToProto(row bigtable.Row) *btpb.Row {
+	pbRow := &btpb.Row{Key: []byte(row.Key())}
+	families := make([]string, 0, len(row))
+	for family := range row {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+
+	for _, family := range families {
+		pbFamily := &btpb.Family{Name: family}
+		var column *btpb.Column
+		for _, item := range row[family] {
+			qualifier := strings.TrimPrefix(item.Column, family+":")
+			if column == nil || string(column.Qualifier) != qualifier {
+				column = &btpb.Column{Qualifier: []byte(qualifier)}
+				pbFamily.Columns = append(pbFamily.Columns, column)
+			}
+			column.Cells = append(column.Cells, &btpb.Cell{
+				TimestampMicros: int64(item.Timestamp),
+				Value:           item.Value,
+				Labels:          item.Labels,
+			})
+		}
+		pbRow.Families = append(pbRow.Families, pbFamily)
+	}
+	return pbRow
+}
+
+// rowRangeFromProto converts a row range into a closed-open one.
+func rowRangeFromProto(r *btpb.RowRange) bigtable.RowRange {
+	var start string
+	switch k := r.GetStartKey().(type) {
+	case *btpb.RowRange_StartKeyClosed:
+		start = string(k.StartKeyClosed)
+	case *btpb.RowRange_StartKeyOpen:
+		start = string(successor(k.StartKeyOpen))
+	}
+	switch k := r.GetEndKey().(type) {
+	case *btpb.RowRange_EndKeyClosed:
+		return bigtable.NewRange(start, string(successor(k.EndKeyClosed)))
+	case *btpb.RowRange_EndKeyOpen:
+		if len(k.EndKeyOpen) > 0 {
+			return bigtable.NewRange(start, string(k.EndKeyOpen))
+		}
+	}
+	return bigtable.InfiniteRange(start)
+}
+
+// rowSetFromProto converts a row set. An empty row set means the whole table.
+func rowSetFromProto(rs *btpb.RowSet) bigtable.RowSet {
+	if len(rs.GetRowRanges()) == 0 {
+		if len(rs.GetRowKeys()) == 0 {
+			return bigtable.InfiniteRange("")
+		}
+		var keys bigtable.RowList
+		for _, key := range rs.GetRowKeys() {
+			keys = append(keys, string(key))
+		}
+		return keys
+	}
+
+	// The Go client can't mix keys and ranges, so each key becomes a single-row range.
+	var ranges bigtable.RowRangeList
+	for _, key := range rs.GetRowKeys() {
+		ranges = append(ranges, bigtable.NewRange(string(key), string(successor(key))))
+	}
+	for _, r := range rs.GetRowRanges() {
+		ranges = append(ranges, rowRangeFromProto(r))
+	}
+	return ranges
+}
+
+// filtersFromProto converts a list of filters.
+func filtersFromProto(pbFilters []*btpb.RowFilter) ([]bigtable.Filter, error) {
+	var filters []bigtable.Filter
+	for _, pbFilter := range pbFilters {
+		filter, err := filterFromProto(pbFilter)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+	return filters, nil
+}
+
+// filterFromProto converts a filter. The filters that the Go client doesn't support, e.g., sink,
+// are reported as Unimplemented.
+func filterFromProto(pbFilter *btpb.RowFilter) (bigtable.Filter, error) {
+	switch f := pbFilter.GetFilter().(type) {
+	case *btpb.RowFilter_Chain_:
+		filters, err := filtersFromProto(f.Chain.GetFilters())
+		if err != nil {
+			return nil, err
+		}
+		return bigtable.ChainFilters(filters...), nil
+	case *btpb.RowFilter_Interleave_:
+		filters, err := filtersFromProto(f.Interleave.GetFilters())
+		if err != nil {
+			return nil, err
+		}
+		return bigtable.InterleaveFilters(filters...), nil
+	case *btpb.RowFilter_Condition_:
+		if f.Condition.GetPredicateFilter() == nil {
+			return nil, status.Error(codes.InvalidArgument, "condition filter without predicate")
+		}
+		predicate, err := filterFromProto(f.Condition.GetPredicateFilter())
+		if err != nil {
+			return nil, err
+		}
+		var trueFilter, falseFilter bigtable.Filter
+		if f.Condition.GetTrueFilter() != nil {
+			if trueFilter, err = filterFromProto(f.Condition.GetTrueFilter()); err != nil {
+				return nil, err
+			}
+		}
+		if f.Condition.GetFalseFilter() != nil {
+			if falseFilter, err = filterFromProto(f.Condition.GetFalseFilter()); err != nil {
+				return nil, err
+			}
+		}
+		return bigtable.ConditionFilter(predicate, trueFilter, falseFilter), nil
+	case *btpb.RowFilter_PassAllFilter:
+		return bigtable.PassAllFilter(), nil
+	case *btpb.RowFilter_BlockAllFilter:
+		return bigtable.BlockAllFilter(), nil
+	case *btpb.RowFilter_RowKeyRegexFilter:
+		return bigtable.RowKeyFilter(string(f.RowKeyRegexFilter)), nil
+	case *btpb.RowFilter_RowSampleFilter:
+		return bigtable.RowSampleFilter(f.RowSampleFilter), nil
+	case *btpb.RowFilter_FamilyNameRegexFilter:
+		return bigtable.FamilyFilter(f.FamilyNameRegexFilter), nil
+	case *btpb.RowFilter_ColumnQualifierRegexFilter:
+		return bigtable.ColumnFilter(string(f.ColumnQualifierRegexFilter)), nil
+	case *btpb.RowFilter_ColumnRangeFilter:
+		r := f.ColumnRangeFilter
+		var start, end string
+		switch q := r.GetStartQualifier().(type) {
+		case *btpb.ColumnRange_StartQualifierClosed:
+			start = string(q.StartQualifierClosed)
+		case *btpb.ColumnRange_StartQualifierOpen:
+			start = string(successor(q.StartQualifierOpen))
+		}
+		switch q := r.GetEndQualifier().(type) {
+		case *btpb.ColumnRange_EndQualifierClosed:
+			end = string(successor(q.EndQualifierClosed))
+		case *btpb.ColumnRange_EndQualifierOpen:
+			end = string(q.EndQualifierOpen)
+		}
+		return bigtable.ColumnRangeFilter(r.GetFamilyName(), start, end), nil
+	case *btpb.RowFilter_TimestampRangeFilter:
+		r := f.TimestampRangeFilter
+		return bigtable.TimestampRangeFilterMicros(bigtable.Timestamp(r.GetStartTimestampMicros()),
+			bigtable.Timestamp(r.GetEndTimestampMicros())), nil
+	case *btpb.RowFilter_ValueRegexFilter:
+		return bigtable.ValueFilter(string(f.ValueRegexFilter)), nil
+	case *btpb.RowFilter_ValueRangeFilter:
+		r := f.ValueRangeFilter
+		var start, end []byte
+		switch v := r.GetStartValue().(type) {
+		case *btpb.ValueRange_StartValueClosed:
+			start = v.StartValueClosed
+		case *btpb.ValueRange_StartValueOpen:
+			start = successor(v.StartValueOpen)
+		}
+		switch v := r.GetEndValue().(type) {
+		case *btpb.ValueRange_EndValueClosed:
+			end = successor(v.EndValueClosed)
+		case *btpb.ValueRange_EndValueOpen:
+			end = v.EndValueOpen
+		}
+		return bigtable.ValueRangeFilter(start, end), nil
+	case *btpb.RowFilter_CellsPerRowOffsetFilter:
+		return bigtable.CellsPerRowOffsetFilter(int(f.CellsPerRowOffsetFilter)), nil
+	case *btpb.RowFilter_CellsPerRowLimitFilter:
+		return bigtable.CellsPerRowLimitFilter(int(f.CellsPerRowLimitFilter)), nil
+	case *btpb.RowFilter_CellsPerColumnLimitFilter:
+		return bigtable.LatestNFilter(int(f.CellsPerColumnLimitFilter)), nil
+	case *btpb.RowFilter_StripValueTransformer:
+		return bigtable.StripValueFilter(), nil
+	case *btpb.RowFilter_ApplyLabelTransformer:
+		return bigtable.LabelFilter(f.ApplyLabelTransformer), nil
+	}
+	return nil, status.Errorf(codes.Unimplemented, "the Go client doesn't support the filter %T", pbFilter.GetFilter())
+}
+
+// mutationFromProto converts a list of mutations of a row into a mutation of the Go client.
+func mutationFromProto(pbMuts []*btpb.Mutation) (*bigtable.Mutation, error) {
+	mut := bigtable.NewMutation()
+	for _, pbMut := range pbMuts {
+		switch m := pbMut.GetMutation().(type) {
+		case *btpb.Mutation_SetCell_:
+			mut.Set(m.SetCell.GetFamilyName(), string(m.SetCell.GetColumnQualifier()),
+				bigtable.Timestamp(m.SetCell.GetTimestampMicros()), m.SetCell.GetValue())
+		case *btpb.Mutation_AddToCell_:
+			mut.AddIntToCell(m.AddToCell.GetFamilyName(), string(m.AddToCell.GetColumnQualifier().GetRawValue()),
+				bigtable.Timestamp(m.AddToCell.GetTimestamp().GetRawTimestampMicros()), m.AddToCell.GetInput().GetIntValue())
+		case *btpb.Mutation_MergeToCell_:
+			mut.MergeBytesToCell(m.MergeToCell.GetFamilyName(), string(m.MergeToCell.GetColumnQualifier().GetRawValue()),
+				bigtable.Timestamp(m.MergeToCell.GetTimestamp().GetRawTimestampMicros()), m.MergeToCell.GetInput().GetRawValue())
+		case *btpb.Mutation_DeleteFromColumn_:
+			family, qualifier := m.DeleteFromColumn.GetFamilyName(), string(m.DeleteFromColumn.GetColumnQualifier())
+			if r := m.DeleteFromColumn.GetTimeRange(); r != nil {
+				mut.DeleteTimestampRange(family, qualifier, bigtable.Timestamp(r.GetStartTimestampMicros()),
+					bigtable.Timestamp(r.GetEndTimestampMicros()))
+			} else {
+				mut.DeleteCellsInColumn(family, qualifier)
+			}
+		case *btpb.Mutation_DeleteFromFamily_:
+			mut.DeleteCellsInFamily(m.DeleteFromFamily.GetFamilyName())
+		case *btpb.Mutation_DeleteFromRow_:
+			mut.DeleteRow()
+		default:
+			return nil, status.Errorf(codes.Unimplemented, "the Go client doesn't support the mutation %T", m)
+		}
+	}
+	return mut, nil
+}
+
+// gcPolicyFromProto converts a garbage collection rule into a policy of the Go client.
+func gcPolicyFromProto(rule *adminpb.GcRule) (bigtable.GCPolicy, error) {
+	var subRules []*adminpb.GcRule
+	switch r := rule.GetRule().(type) {
+	case nil:
+		return bigtable.NoGcPolicy(), nil
+	case *adminpb.GcRule_MaxNumVersions:
+		return bigtable.MaxVersionsPolicy(int(r.MaxNumVersions)), nil
+	case *adminpb.GcRule_MaxAge:
+		return bigtable.MaxAgePolicy(r.MaxAge.AsDuration()), nil
+	case *adminpb.GcRule_Intersection_:
+		subRules = r.Intersection.GetRules()
+	case *adminpb.GcRule_Union_:
+		subRules = r.Union.GetRules()
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "the Go client doesn't support the GC rule %T", r)
+	}
+	policies := make([]bigtable.GCPolicy, len(subRules))
+	for i, subRule := range subRules {
+		var err error
+		if policies[i], err = gcPolicyFromProto(subRule); err != nil {
+			return nil, err
+		}
+	}
+	if rule.GetIntersection() != nil {
+		return bigtable.IntersectionPolicy(policies...), nil
+	}
+	return bigtable.UnionPolicy(policies...), nil
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Command testproxy is a reference test proxy, which implements the
+// CloudBigtableV2TestProxy service on top of the Go client
+// cloud.google.com/go/bigtable. It shows the proxy authors how the proxy API
+// maps to a client library, and lets the test cases run without the toolchain
+// of another language. Sample usage:
+//
+//	go run ./cmd/testproxy -port=9999
+//
+// Or build it, and let the test cases launch the binary:
+//
+//	go build -o /tmp/testproxy ./cmd/testproxy
+//	cd tests && go test -v -proxy_cmd="/tmp/testproxy -port={port}"
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+)
+
+var port = flag.Int("port", 9999, "The port of the test proxy.")
+
+func main() {
+	flag.Parse()
+
+	// The client traces the operations with the global tracer provider, and the connections
+	// propagate the spans of the attempts with the global propagator. The spans aren't exported, as
+	// the tests only check their propagation.
+	otel.SetTracerProvider(sdktrace.NewTracerProvider())
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
+	if err != nil {
+		log.Fatalf("Failed to listen on port %d: %v", *port, err)
+	}
+	s := grpc.NewServer()
+	testproxypb.RegisterCloudBigtableV2TestProxyServer(s, newProxyServer())
+	log.Printf("Test proxy listening at %v", lis.Addr())
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("Failed to serve: %v", err)
+	}
+}
@@ -0,0 +1,510 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/bigtable"
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/api/option"
+	statpb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+// testClient is a client created by CreateClient.
+type testClient struct {
+	client       *bigtable.Client
+	admin        *bigtable.AdminClient
+	instanceName string // Full name of the instance, i.e., projects/<project>/instances/<instance>.
+	appProfile   string
+	timeout      time.Duration // Per-operation timeout, 0 means the default of the client library.
+}
+
+// withTimeout returns `ctx` with the per-operation timeout of the client if set.
+func (c *testClient) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout > 0 {
+		return context.WithTimeout(ctx, c.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+// withTrace returns `ctx` with the remote span of the W3C `traceparent` if set, so that the client
+// traces the operation as a child of the span.
+func withTrace(ctx context.Context, traceparent string) context.Context {
+	if traceparent == "" {
+		return ctx
+	}
+	return propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": traceparent})
+}
+
+// proxyServer implements the CloudBigtableV2TestProxy service.
+type proxyServer struct {
+	testproxypb.UnimplementedCloudBigtableV2TestProxyServer
+
+	mu      sync.RWMutex
+	clients map[string]*testClient
+}
+
+func newProxyServer() *proxyServer {
+	return &proxyServer{clients: make(map[string]*testClient)}
+}
+
+// client returns the client with ID `clientID`. An unknown ID is a bug of the test case, so it's
+// returned as an RPC error rather than a result status.
+func (s *proxyServer) client(clientID string) (*testClient, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	c, ok := s.clients[clientID]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "client %q doesn't exist", clientID)
+	}
+	return c, nil
+}
+
+// statusFromError converts the error of the client library into the status of a result.
+func statusFromError(err error) *statpb.Status {
+	if err == nil {
+		return &statpb.Status{}
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		// The error of a canceled or expired context may not be a gRPC status, e.g., when it
+		// happens during a retry backoff. Any other error comes from the client library itself,
+		// e.g., a response that it fails to decode, which is an internal error.
+		if st = status.FromContextError(err); st.Code() == codes.Unknown {
+			st = status.New(codes.Internal, err.Error())
+		}
+	}
+	return st.Proto()
+}
+
+// accessToken is the per-RPC credentials from SecurityOptions.access_token.
+type accessToken string
+
+func (t accessToken) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity makes gRPC refuse to send the token over a plaintext connection.
+func (t accessToken) RequireTransportSecurity() bool {
+	return true
+}
+
+// insecureAccessToken is the access token when SecurityOptions.allow_insecure_access_token lets
+// it be sent over a plaintext connection.
+type insecureAccessToken struct {
+	accessToken
+}
+
+func (t insecureAccessToken) RequireTransportSecurity() bool {
+	return false
+}
+
+// dialOptions returns the options to connect to the data target with the security options of
+// `req`.
+func dialOptions(req *testproxypb.CreateClientRequest) ([]grpc.DialOption, error) {
+	sec := req.GetSecurityOptions()
+	if !sec.GetUseSsl() {
+		opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+		if token := sec.GetAccessToken(); token != "" {
+			if !sec.GetAllowInsecureAccessToken() {
+				log.Printf("Client %q: the access token is ignored without SSL", req.GetClientId())
+				return opts, nil
+			}
+			opts = append(opts, grpc.WithPerRPCCredentials(insecureAccessToken{accessToken(token)}))
+		}
+		return opts, nil
+	}
+
+	config := &tls.Config{ServerName: sec.GetSslEndpointOverride()}
+	if pem := sec.GetSslRootCertsPem(); pem != "" {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM([]byte(pem)) {
+			return nil, errors.New("no valid certificate in ssl_root_certs_pem")
+		}
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(config))}
+	if override := sec.GetSslEndpointOverride(); override != "" {
+		opts = append(opts, grpc.WithAuthority(override))
+	}
+	if token := sec.GetAccessToken(); token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(accessToken(token)))
+	}
+	return opts, nil
+}
+
+// CreateClient creates a client of the Go client library. The client has its own connection to
+// the data target, which is shared with the admin client for the table admin methods.
+//
+// OptionalFeatureConfig needs no setting here, as the Go client always enables the optional
+// features that it supports.
+func (s *proxyServer) CreateClient(ctx context.Context, req *testproxypb.CreateClientRequest) (*testproxypb.CreateClientResponse, error) {
+	if req.GetClientId() == "" || req.GetDataTarget() == "" || req.GetProjectId() == "" || req.GetInstanceId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "client_id, data_target, project_id and instance_id are required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clients[req.GetClientId()]; ok {
+		return nil, status.Errorf(codes.AlreadyExists, "client %q already exists", req.GetClientId())
+	}
+
+	target := req.GetDataTarget()
+	if target == "emulator" {
+		if target = os.Getenv("BIGTABLE_EMULATOR_HOST"); target == "" {
+			return nil, status.Error(codes.FailedPrecondition, "BIGTABLE_EMULATOR_HOST is not set")
+		}
+	}
+	// The client exports its metrics through the connection of the data API, so they can only go to
+	// the data target.
+	if req.GetMetricsTarget() != "" && req.GetMetricsTarget() != req.GetDataTarget() {
+		return nil, status.Errorf(codes.InvalidArgument, "metrics_target %q must be the same as data_target", req.GetMetricsTarget())
+	}
+	opts, err := dialOptions(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid security options: %v", err)
+	}
+	opts = append(opts, grpc.WithChainUnaryInterceptor(capturePrepareQuery), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
+	conn, err := grpc.NewClient(target, opts...)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to connect to %s: %v", target, err)
+	}
+
+	config := bigtable.ClientConfig{AppProfile: req.GetAppProfileId()}
+	if req.GetMetricsTarget() == "" {
+		config.MetricsProvider = bigtable.NoopMetricsProvider{}
+	}
+	// The metrics are exported with the context of the client when it's closed, so it must outlive
+	// this call.
+	client, err := bigtable.NewClientWithConfig(context.Background(), req.GetProjectId(), req.GetInstanceId(), config, option.WithGRPCConn(conn))
+	if err != nil {
+		conn.Close()
+		return nil, status.Errorf(codes.Internal, "failed to create the client: %v", err)
+	}
+	admin, err := bigtable.NewAdminClient(context.Background(), req.GetProjectId(), req.GetInstanceId(), option.WithGRPCConn(conn))
+	if err != nil {
+		client.Close()
+		return nil, status.Errorf(codes.Internal, "failed to create the admin client: %v", err)
+	}
+
+	s.clients[req.GetClientId()] = &testClient{
+		client:       client,
+		admin:        admin,
+		instanceName: "projects/" + req.GetProjectId() + "/instances/" + req.GetInstanceId(),
+		appProfile:   req.GetAppProfileId(),
+		timeout:      req.GetPerOperationTimeout().AsDuration(),
+	}
+	return &testproxypb.CreateClientResponse{}, nil
+}
+
+// CloseClient closes the client. The ongoing operations are canceled, and the new ones fail.
+func (s *proxyServer) CloseClient(ctx context.Context, req *testproxypb.CloseClientRequest) (*testproxypb.CloseClientResponse, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	// The connection is closed with the client, which cancels the admin client as well.
+	c.client.Close()
+	return &testproxypb.CloseClientResponse{}, nil
+}
+
+// RemoveClient removes the client without closing it.
+func (s *proxyServer) RemoveClient(ctx context.Context, req *testproxypb.RemoveClientRequest) (*testproxypb.RemoveClientResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.clients[req.GetClientId()]; !ok {
+		return nil, status.Errorf(codes.NotFound, "client %q doesn't exist", req.GetClientId())
+	}
+	delete(s.clients, req.GetClientId())
+	return &testproxypb.RemoveClientResponse{}, nil
+}
+
+// tableID returns the table ID of the table name "projects/<p>/instances/<i>/tables/<t>".
+func tableID(tableName string) (string, error) {
+	i := strings.LastIndex(tableName, "/tables/")
+	if i < 0 || tableName[i+len("/tables/"):] == "" {
+		return "", status.Errorf(codes.InvalidArgument, "invalid table name %q", tableName)
+	}
+	return tableName[i+len("/tables/"):], nil
+}
+
+// openTable opens the table of `tableName`, or the authorized view of `viewName` if it's set, which
+// is "projects/<p>/instances/<i>/tables/<t>/authorizedViews/<v>".
+func openTable(client *bigtable.Client, tableName string, viewName string) (bigtable.TableAPI, error) {
+	if viewName == "" {
+		id, err := tableID(tableName)
+		if err != nil {
+			return nil, err
+		}
+		return client.OpenTable(id), nil
+	}
+	i := strings.LastIndex(viewName, "/authorizedViews/")
+	if i < 0 || viewName[i+len("/authorizedViews/"):] == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid authorized view name %q", viewName)
+	}
+	id, err := tableID(viewName[:i])
+	if err != nil {
+		return nil, err
+	}
+	return client.OpenAuthorizedView(id, viewName[i+len("/authorizedViews/"):]), nil
+}
+
+// ReadRow reads a row with the optional filter.
+func (s *proxyServer) ReadRow(ctx context.Context, req *testproxypb.ReadRowRequest) (*testproxypb.RowResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	table, err := openTable(c.client, req.GetTableName(), "")
+	if err != nil {
+		return nil, err
+	}
+	var opts []bigtable.ReadOption
+	if req.GetFilter() != nil {
+		filter, err := filterFromProto(req.GetFilter())
+		if err != nil {
+			return &testproxypb.RowResult{Status: statusFromError(err)}, nil
+		}
+		opts = append(opts, bigtable.RowFilter(filter))
+	}
+
+	ctx, cancel := c.withTimeout(withTrace(ctx, req.GetTraceparent()))
+	defer cancel()
+	row, err := table.ReadRow(ctx, req.GetRowKey(), opts...)
+	if err != nil {
+		return &testproxypb.RowResult{Status: statusFromError(err)}, nil
+	}
+	res := &testproxypb.RowResult{Status: statusFromError(nil)}
+	if row != nil {
+		res.Row = rowToProto(row)
+	}
+	return res, nil
+}
+
+// ReadRows reads the rows of the row set with the optional filter, limit and order, and stops
+// early after `cancel_after_rows` rows if it's positive.
+func (s *proxyServer) ReadRows(ctx context.Context, req *testproxypb.ReadRowsRequest) (*testproxypb.RowsResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	rrq := req.GetRequest()
+	table, err := openTable(c.client, rrq.GetTableName(), rrq.GetAuthorizedViewName())
+	if err != nil {
+		return nil, err
+	}
+	var opts []bigtable.ReadOption
+	if rrq.GetFilter() != nil {
+		filter, err := filterFromProto(rrq.GetFilter())
+		if err != nil {
+			return &testproxypb.RowsResult{Status: statusFromError(err)}, nil
+		}
+		opts = append(opts, bigtable.RowFilter(filter))
+	}
+	if rrq.GetRowsLimit() > 0 {
+		opts = append(opts, bigtable.LimitRows(rrq.GetRowsLimit()))
+	}
+	if rrq.GetReversed() {
+		opts = append(opts, bigtable.ReverseScan())
+	}
+
+	ctx, cancel := c.withTimeout(withTrace(ctx, req.GetTraceparent()))
+	defer cancel()
+	res := &testproxypb.RowsResult{}
+	err = table.ReadRows(ctx, rowSetFromProto(rrq.GetRows()), func(row bigtable.Row) bool {
+		res.Rows = append(res.Rows, rowToProto(row))
+		return req.GetCancelAfterRows() <= 0 || len(res.Rows) < int(req.GetCancelAfterRows())
+	}, opts...)
+	res.Status = statusFromError(err)
+	return res, nil
+}
+
+// MutateRow applies the mutations to a row.
+func (s *proxyServer) MutateRow(ctx context.Context, req *testproxypb.MutateRowRequest) (*testproxypb.MutateRowResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	table, err := openTable(c.client, req.GetRequest().GetTableName(), req.GetRequest().GetAuthorizedViewName())
+	if err != nil {
+		return nil, err
+	}
+	mut, err := mutationFromProto(req.GetRequest().GetMutations())
+	if err != nil {
+		return &testproxypb.MutateRowResult{Status: statusFromError(err)}, nil
+	}
+
+	ctx, cancel := c.withTimeout(withTrace(ctx, req.GetTraceparent()))
+	defer cancel()
+	err = table.Apply(ctx, string(req.GetRequest().GetRowKey()), mut)
+	return &testproxypb.MutateRowResult{Status: statusFromError(err)}, nil
+}
+
+// BulkMutateRows applies the mutations to several rows. The failed entries are reported with
+// their indexes.
+func (s *proxyServer) BulkMutateRows(ctx context.Context, req *testproxypb.MutateRowsRequest) (*testproxypb.MutateRowsResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	table, err := openTable(c.client, req.GetRequest().GetTableName(), req.GetRequest().GetAuthorizedViewName())
+	if err != nil {
+		return nil, err
+	}
+	entries := req.GetRequest().GetEntries()
+	keys := make([]string, len(entries))
+	muts := make([]*bigtable.Mutation, len(entries))
+	for i, entry := range entries {
+		keys[i] = string(entry.GetRowKey())
+		if muts[i], err = mutationFromProto(entry.GetMutations()); err != nil {
+			return &testproxypb.MutateRowsResult{Status: statusFromError(err)}, nil
+		}
+	}
+
+	ctx, cancel := c.withTimeout(withTrace(ctx, req.GetTraceparent()))
+	defer cancel()
+	errs, err := table.ApplyBulk(ctx, keys, muts)
+	res := &testproxypb.MutateRowsResult{Status: statusFromError(err)}
+	if err != nil {
+		// The Go client only returns the error of the whole operation, which fails all the rows.
+		errs = make([]error, len(entries))
+		for i := range errs {
+			errs[i] = err
+		}
+	}
+	for i, e := range errs {
+		if e != nil {
+			res.Entries = append(res.Entries, &btpb.MutateRowsResponse_Entry{
+				Index:  int64(i),
+				Status: statusFromError(e),
+			})
+		}
+	}
+	return res, nil
+}
+
+// CheckAndMutateRow applies the true or false mutations depending on the predicate filter.
+func (s *proxyServer) CheckAndMutateRow(ctx context.Context, req *testproxypb.CheckAndMutateRowRequest) (*testproxypb.CheckAndMutateRowResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	rrq := req.GetRequest()
+	table, err := openTable(c.client, rrq.GetTableName(), rrq.GetAuthorizedViewName())
+	if err != nil {
+		return nil, err
+	}
+	var predicate bigtable.Filter
+	if rrq.GetPredicateFilter() != nil {
+		if predicate, err = filterFromProto(rrq.GetPredicateFilter()); err != nil {
+			return &testproxypb.CheckAndMutateRowResult{Status: statusFromError(err)}, nil
+		}
+	}
+	trueMut, err := mutationFromProto(rrq.GetTrueMutations())
+	if err != nil {
+		return &testproxypb.CheckAndMutateRowResult{Status: statusFromError(err)}, nil
+	}
+	falseMut, err := mutationFromProto(rrq.GetFalseMutations())
+	if err != nil {
+		return &testproxypb.CheckAndMutateRowResult{Status: statusFromError(err)}, nil
+	}
+
+	ctx, cancel := c.withTimeout(withTrace(ctx, req.GetTraceparent()))
+	defer cancel()
+	var matched bool
+	err = table.Apply(ctx, string(rrq.GetRowKey()), bigtable.NewCondMutation(predicate, trueMut, falseMut),
+		bigtable.GetCondMutationResult(&matched))
+	if err != nil {
+		return &testproxypb.CheckAndMutateRowResult{Status: statusFromError(err)}, nil
+	}
+	return &testproxypb.CheckAndMutateRowResult{
+		Status: statusFromError(nil),
+		Result: &btpb.CheckAndMutateRowResponse{PredicateMatched: matched},
+	}, nil
+}
+
+// SampleRowKeys samples the row keys of the table. The Go client only returns the keys, so the
+// offsets are left unset.
+func (s *proxyServer) SampleRowKeys(ctx context.Context, req *testproxypb.SampleRowKeysRequest) (*testproxypb.SampleRowKeysResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	table, err := openTable(c.client, req.GetRequest().GetTableName(), req.GetRequest().GetAuthorizedViewName())
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := c.withTimeout(withTrace(ctx, req.GetTraceparent()))
+	defer cancel()
+	keys, err := table.SampleRowKeys(ctx)
+	if err != nil {
+		return &testproxypb.SampleRowKeysResult{Status: statusFromError(err)}, nil
+	}
+	res := &testproxypb.SampleRowKeysResult{Status: statusFromError(nil)}
+	for _, key := range keys {
+		res.Samples = append(res.Samples, &btpb.SampleRowKeysResponse{RowKey: []byte(key)})
+	}
+	return res, nil
+}
+
+// ReadModifyWriteRow applies the append and increment rules to a row, and returns the new cells.
+func (s *proxyServer) ReadModifyWriteRow(ctx context.Context, req *testproxypb.ReadModifyWriteRowRequest) (*testproxypb.RowResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	rrq := req.GetRequest()
+	table, err := openTable(c.client, rrq.GetTableName(), rrq.GetAuthorizedViewName())
+	if err != nil {
+		return nil, err
+	}
+	rmw := bigtable.NewReadModifyWrite()
+	for _, rule := range rrq.GetRules() {
+		switch r := rule.GetRule().(type) {
+		case *btpb.ReadModifyWriteRule_AppendValue:
+			rmw.AppendValue(rule.GetFamilyName(), string(rule.GetColumnQualifier()), r.AppendValue)
+		case *btpb.ReadModifyWriteRule_IncrementAmount:
+			rmw.Increment(rule.GetFamilyName(), string(rule.GetColumnQualifier()), r.IncrementAmount)
+		default:
+			err := status.Errorf(codes.InvalidArgument, "unsupported rule %T", r)
+			return &testproxypb.RowResult{Status: statusFromError(err)}, nil
+		}
+	}
+
+	ctx, cancel := c.withTimeout(withTrace(ctx, req.GetTraceparent()))
+	defer cancel()
+	row, err := table.ApplyReadModifyWrite(ctx, string(rrq.GetRowKey()), rmw)
+	if err != nil {
+		return &testproxypb.RowResult{Status: statusFromError(err)}, nil
+	}
+	return &testproxypb.RowResult{Status: statusFromError(nil), Row: rowToProto(row)}, nil
+}
@@ -0,0 +1,367 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file implements ExecuteQuery with PrepareStatement, Bind and Execute of
+// the Go client. The Go client returns the values as Go types, which are
+// converted back to protos with the column types of the result set metadata.
+// The Go client doesn't expose the metadata, so it's captured from the
+// PrepareQuery responses by an interceptor on the connection of the client.
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"cloud.google.com/go/bigtable"
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"cloud.google.com/go/civil"
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"google.golang.org/genproto/googleapis/type/date"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// preparedMetadata is the result set metadata of the last PrepareQuery response of an operation,
+// including the ones of the plan refreshes.
+type preparedMetadata struct {
+	mu       sync.Mutex
+	metadata *btpb.ResultSetMetadata
+}
+
+func (m *preparedMetadata) set(metadata *btpb.ResultSetMetadata) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.metadata = metadata
+}
+
+func (m *preparedMetadata) get() *btpb.ResultSetMetadata {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.metadata
+}
+
+type preparedMetadataKey struct{}
+
+// capturePrepareQuery is the interceptor that saves the metadata of the PrepareQuery responses into
+// the preparedMetadata of the context, if any.
+func capturePrepareQuery(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	if m, ok := ctx.Value(preparedMetadataKey{}).(*preparedMetadata); ok && err == nil {
+		if res, ok := reply.(*btpb.PrepareQueryResponse); ok {
+			m.set(res.GetMetadata())
+		}
+	}
+	return err
+}
+
+// ExecuteQuery prepares the query of the request, binds the parameters and executes it. The
+// request must have the query rather than a prepared query, as the Go client prepares the query
+// itself.
+func (s *proxyServer) ExecuteQuery(ctx context.Context, req *testproxypb.ExecuteQueryRequest) (*testproxypb.ExecuteQueryResult, error) {
+	c, err := s.client(req.GetClientId())
+	if err != nil {
+		return nil, err
+	}
+	rrq := req.GetRequest()
+	if rrq.GetQuery() == "" {
+		err := status.Error(codes.Unimplemented, "the Go client can only execute a query string")
+		return &testproxypb.ExecuteQueryResult{Status: statusFromError(err)}, nil
+	}
+	paramTypes := make(map[string]bigtable.SQLType)
+	params := make(map[string]any)
+	for name, param := range rrq.GetParams() {
+		if paramTypes[name], err = sqlTypeFromProto(param.GetType()); err != nil {
+			return &testproxypb.ExecuteQueryResult{Status: statusFromError(err)}, nil
+		}
+		if params[name], err = goValueFromProto(param, param.GetType()); err != nil {
+			return &testproxypb.ExecuteQueryResult{Status: statusFromError(err)}, nil
+		}
+	}
+
+	ctx, cancel := c.withTimeout(withTrace(ctx, req.GetTraceparent()))
+	defer cancel()
+	metadata := &preparedMetadata{}
+	ctx = context.WithValue(ctx, preparedMetadataKey{}, metadata)
+	stmt, err := c.client.PrepareStatement(ctx, rrq.GetQuery(), paramTypes)
+	if err != nil {
+		return &testproxypb.ExecuteQueryResult{Status: statusFromError(err)}, nil
+	}
+	if err := validateMetadata(metadata.get()); err != nil {
+		return &testproxypb.ExecuteQueryResult{Status: statusFromError(err)}, nil
+	}
+	bound, err := stmt.Bind(params)
+	if err != nil {
+		err = status.Error(codes.InvalidArgument, err.Error())
+		return &testproxypb.ExecuteQueryResult{Status: statusFromError(err)}, nil
+	}
+
+	var rows []*testproxypb.SqlRow
+	var rowErr error
+	err = bound.Execute(ctx, func(row bigtable.ResultRow) bool {
+		var sqlRow *testproxypb.SqlRow
+		if sqlRow, rowErr = sqlRowToProto(row, metadata.get()); rowErr != nil {
+			return false
+		}
+		rows = append(rows, sqlRow)
+		return true
+	})
+	if err == nil {
+		err = rowErr
+	}
+	if err != nil {
+		return &testproxypb.ExecuteQueryResult{Status: statusFromError(err)}, nil
+	}
+	return &testproxypb.ExecuteQueryResult{
+		Status:   statusFromError(nil),
+		Metadata: &testproxypb.ResultSetMetadata{Columns: metadata.get().GetProtoSchema().GetColumns()},
+		Rows:     rows,
+	}, nil
+}
+
+// validateMetadata checks the prepared metadata before the query is executed. The Go client only
+// checks the column types when it decodes the values, and doesn't check the columns at all.
+func validateMetadata(metadata *btpb.ResultSetMetadata) error {
+	columns := metadata.GetProtoSchema().GetColumns()
+	if len(columns) == 0 {
+		return status.Error(codes.Internal, "the result set metadata is invalid: columns cannot be empty")
+	}
+	for _, column := range columns {
+		if column.GetType().GetKind() == nil {
+			return status.Errorf(codes.Internal, "the result set metadata is invalid: column type cannot be empty for column %q", column.GetName())
+		}
+	}
+	return nil
+}
+
+// sqlTypeFromProto converts the type of a parameter.
+func sqlTypeFromProto(t *btpb.Type) (bigtable.SQLType, error) {
+	switch k := t.GetKind().(type) {
+	case *btpb.Type_BytesType:
+		return bigtable.BytesSQLType{}, nil
+	case *btpb.Type_StringType:
+		return bigtable.StringSQLType{}, nil
+	case *btpb.Type_Int64Type:
+		return bigtable.Int64SQLType{}, nil
+	case *btpb.Type_Float32Type:
+		return bigtable.Float32SQLType{}, nil
+	case *btpb.Type_Float64Type:
+		return bigtable.Float64SQLType{}, nil
+	case *btpb.Type_BoolType:
+		return bigtable.BoolSQLType{}, nil
+	case *btpb.Type_TimestampType:
+		return bigtable.TimestampSQLType{}, nil
+	case *btpb.Type_DateType:
+		return bigtable.DateSQLType{}, nil
+	case *btpb.Type_ArrayType:
+		elem, err := sqlTypeFromProto(k.ArrayType.GetElementType())
+		if err != nil {
+			return nil, err
+		}
+		return bigtable.ArraySQLType{ElemType: elem}, nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "unsupported parameter type %T", t.GetKind())
+}
+
+// goValueFromProto converts the value of a parameter with type `t`. Null is nil.
+func goValueFromProto(v *btpb.Value, t *btpb.Type) (any, error) {
+	if v.GetKind() == nil {
+		return nil, nil
+	}
+	switch t.GetKind().(type) {
+	case *btpb.Type_BytesType:
+		return v.GetBytesValue(), nil
+	case *btpb.Type_StringType:
+		return v.GetStringValue(), nil
+	case *btpb.Type_Int64Type:
+		return v.GetIntValue(), nil
+	case *btpb.Type_Float32Type:
+		return float32(v.GetFloatValue()), nil
+	case *btpb.Type_Float64Type:
+		return v.GetFloatValue(), nil
+	case *btpb.Type_BoolType:
+		return v.GetBoolValue(), nil
+	case *btpb.Type_TimestampType:
+		return v.GetTimestampValue().AsTime(), nil
+	case *btpb.Type_DateType:
+		d := v.GetDateValue()
+		return civil.Date{Year: int(d.GetYear()), Month: time.Month(d.GetMonth()), Day: int(d.GetDay())}, nil
+	case *btpb.Type_ArrayType:
+		elems := make([]any, 0, len(v.GetArrayValue().GetValues()))
+		for _, e := range v.GetArrayValue().GetValues() {
+			elem, err := goValueFromProto(e, t.GetArrayType().GetElementType())
+			if err != nil {
+				return nil, err
+			}
+			elems = append(elems, elem)
+		}
+		return elems, nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "unsupported parameter type %T", t.GetKind())
+}
+
+// sqlRowToProto converts a result row with the metadata of its result set.
+func sqlRowToProto(row bigtable.ResultRow, metadata *btpb.ResultSetMetadata) (*testproxypb.SqlRow, error) {
+	columns := metadata.GetProtoSchema().GetColumns()
+	if len(columns) != len(row.Metadata.Columns) {
+		return nil, status.Errorf(codes.Internal, "the row has %d columns, but the metadata has %d",
+			len(row.Metadata.Columns), len(columns))
+	}
+	sqlRow := &testproxypb.SqlRow{}
+	for i, column := range columns {
+		var v any
+		if err := row.GetByIndex(i, &v); err != nil {
+			return nil, err
+		}
+		pbValue, err := goValueToProto(v, column.GetType())
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %v", column.GetName(), err)
+		}
+		sqlRow.Values = append(sqlRow.Values, pbValue)
+	}
+	return sqlRow, nil
+}
+
+// goValueToProto converts a value returned by the Go client with type `t`. The Go client returns
+// the nullable values as pointers, the arrays as slices, the maps as Go maps (with base64 keys
+// for bytes), and the structs as bigtable.Struct. The map entries are sorted by key, as the Go
+// map doesn't keep the order.
+func goValueToProto(v any, t *btpb.Type) (*btpb.Value, error) {
+	rv := reflect.ValueOf(v)
+	for rv.IsValid() && (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface) {
+		if rv.IsNil() {
+			return &btpb.Value{}, nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return &btpb.Value{}, nil
+	}
+	v = rv.Interface()
+
+	mismatch := status.Errorf(codes.Internal, "unexpected value %T for type %T", v, t.GetKind())
+	switch k := t.GetKind().(type) {
+	case *btpb.Type_BytesType:
+		if b, ok := v.([]byte); ok {
+			// The Go client returns a null element of a bytes array as nil rather than a pointer.
+			if b == nil {
+				return &btpb.Value{}, nil
+			}
+			return &btpb.Value{Kind: &btpb.Value_BytesValue{BytesValue: b}}, nil
+		}
+	case *btpb.Type_StringType:
+		if s, ok := v.(string); ok {
+			return &btpb.Value{Kind: &btpb.Value_StringValue{StringValue: s}}, nil
+		}
+	case *btpb.Type_Int64Type:
+		if i, ok := v.(int64); ok {
+			return &btpb.Value{Kind: &btpb.Value_IntValue{IntValue: i}}, nil
+		}
+	case *btpb.Type_Float32Type:
+		if f, ok := v.(float32); ok {
+			return &btpb.Value{Kind: &btpb.Value_FloatValue{FloatValue: float64(f)}}, nil
+		}
+	case *btpb.Type_Float64Type:
+		if f, ok := v.(float64); ok {
+			return &btpb.Value{Kind: &btpb.Value_FloatValue{FloatValue: f}}, nil
+		}
+	case *btpb.Type_BoolType:
+		if b, ok := v.(bool); ok {
+			return &btpb.Value{Kind: &btpb.Value_BoolValue{BoolValue: b}}, nil
+		}
+	case *btpb.Type_TimestampType:
+		if ts, ok := v.(time.Time); ok {
+			return &btpb.Value{Kind: &btpb.Value_TimestampValue{TimestampValue: timestamppb.New(ts)}}, nil
+		}
+	case *btpb.Type_DateType:
+		if d, ok := v.(civil.Date); ok {
+			return &btpb.Value{Kind: &btpb.Value_DateValue{
+				DateValue: &date.Date{Year: int32(d.Year), Month: int32(d.Month), Day: int32(d.Day)},
+			}}, nil
+		}
+	case *btpb.Type_ArrayType:
+		if rv.Kind() != reflect.Slice {
+			return nil, mismatch
+		}
+		if rv.IsNil() {
+			return &btpb.Value{}, nil
+		}
+		values := make([]*btpb.Value, rv.Len())
+		for i := range values {
+			var err error
+			if values[i], err = goValueToProto(rv.Index(i).Interface(), k.ArrayType.GetElementType()); err != nil {
+				return nil, err
+			}
+		}
+		return arrayValue(values), nil
+	case *btpb.Type_MapType:
+		if rv.Kind() != reflect.Map {
+			return nil, mismatch
+		}
+		if rv.IsNil() {
+			return &btpb.Value{}, nil
+		}
+		keys := rv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		var entries []*btpb.Value
+		for _, key := range keys {
+			goKey := key.Interface()
+			if _, ok := k.MapType.GetKeyType().GetKind().(*btpb.Type_BytesType); ok {
+				b, err := base64.StdEncoding.DecodeString(key.String())
+				if err != nil {
+					return nil, err
+				}
+				goKey = b
+			}
+			pbKey, err := goValueToProto(goKey, k.MapType.GetKeyType())
+			if err != nil {
+				return nil, err
+			}
+			pbValue, err := goValueToProto(rv.MapIndex(key).Interface(), k.MapType.GetValueType())
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, arrayValue([]*btpb.Value{pbKey, pbValue}))
+		}
+		return arrayValue(entries), nil
+	case *btpb.Type_StructType:
+		s, ok := v.(bigtable.Struct)
+		if !ok || s.Len() != len(k.StructType.GetFields()) {
+			return nil, mismatch
+		}
+		fields := make([]*btpb.Value, s.Len())
+		for i, field := range k.StructType.GetFields() {
+			var fv any
+			if err := s.GetByIndex(i, &fv); err != nil {
+				return nil, err
+			}
+			var err error
+			if fields[i], err = goValueToProto(fv, field.GetType()); err != nil {
+				return nil, err
+			}
+		}
+		return arrayValue(fields), nil
+	}
+	return nil, mismatch
+}
+
+func arrayValue(values []*btpb.Value) *btpb.Value {
+	return &btpb.Value{Kind: &btpb.Value_ArrayValue{ArrayValue: &btpb.ArrayValue{Values: values}}}
+}
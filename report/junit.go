@@ -0,0 +1,89 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// junitSuites is the root of a JUnit XML report, in the format understood by most CI systems.
+type junitSuites struct {
+	XMLName xml.Name      `xml:"testsuites"`
+	Suites  []*junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string       `xml:"name,attr"`
+	Tests    int          `xml:"tests,attr"`
+	Failures int          `xml:"failures,attr"`
+	Skipped  int          `xml:"skipped,attr"`
+	Time     string       `xml:"time,attr"`
+	Cases    []*junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"` // "<method>.<tag>", so that CI systems group the cases.
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Output  string `xml:",chardata"`
+}
+
+// WriteJUnit writes the summary as a JUnit XML report to `w`, with a test suite named after the
+// client.
+func (s *Summary) WriteJUnit(w io.Writer) error {
+	suite := &junitSuite{Name: s.Client}
+	var total float64
+	for _, result := range s.Results() {
+		c := &junitCase{
+			Name:      result.Name,
+			ClassName: result.Method,
+			Time:      fmt.Sprintf("%.3f", result.Elapsed),
+		}
+		if result.Tag != "" {
+			c.ClassName += "." + result.Tag
+		}
+		switch result.Outcome {
+		case Fail:
+			c.Failure = &junitMessage{Message: "failed", Output: result.Output}
+			suite.Failures++
+		case Skip:
+			c.Skipped = &junitMessage{Message: "skipped", Output: result.Output}
+			suite.Skipped++
+		}
+		suite.Cases = append(suite.Cases, c)
+		total += result.Elapsed
+	}
+	suite.Tests = len(suite.Cases)
+	suite.Time = fmt.Sprintf("%.3f", total)
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(&junitSuites{Suites: []*junitSuite{suite}}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Matrix is the compatibility matrix of several clients, which shows the outcomes per method and
+// tag, and per test case.
+type Matrix struct {
+	Clients []string
+	Groups  []*MatrixRow // One row per method and tag.
+	Tests   []*MatrixRow // One row per test case.
+}
+
+// MatrixRow is a row of the matrix, with a cell per client in the order of Matrix.Clients.
+type MatrixRow struct {
+	Name  string
+	Cells []*MatrixCell
+}
+
+// MatrixCell is the outcomes of a client in a row. A client that didn't run any test of the row
+// has all the counts zero.
+type MatrixCell struct {
+	Pass, Fail, Skip int
+}
+
+// Total returns the number of the outcomes in the cell.
+func (c *MatrixCell) Total() int {
+	return c.Pass + c.Fail + c.Skip
+}
+
+// Text returns the cell as text, e.g., "pass" for a test case, or "3/4 (1 fail)" for a group.
+func (c *MatrixCell) Text() string {
+	switch {
+	case c.Total() == 0:
+		return "-"
+	case c.Total() == 1 && c.Pass == 1:
+		return Pass
+	case c.Total() == 1 && c.Fail == 1:
+		return Fail
+	case c.Total() == 1:
+		return Skip
+	}
+	text := fmt.Sprintf("%d/%d", c.Pass, c.Total())
+	var notes []string
+	if c.Fail > 0 {
+		notes = append(notes, fmt.Sprintf("%d fail", c.Fail))
+	}
+	if c.Skip > 0 {
+		notes = append(notes, fmt.Sprintf("%d skip", c.Skip))
+	}
+	if len(notes) > 0 {
+		text += " (" + strings.Join(notes, ", ") + ")"
+	}
+	return text
+}
+
+// Class returns the CSS class of the cell in the HTML matrix.
+func (c *MatrixCell) Class() string {
+	switch {
+	case c.Total() == 0:
+		return "none"
+	case c.Fail > 0:
+		return Fail
+	case c.Pass == 0:
+		return Skip
+	}
+	return Pass
+}
+
+func (c *MatrixCell) add(outcome string) {
+	switch outcome {
+	case Pass:
+		c.Pass++
+	case Fail:
+		c.Fail++
+	case Skip:
+		c.Skip++
+	}
+}
+
+// NewMatrix merges the summaries of the clients into a matrix. The columns follow the order of
+// `summaries`.
+func NewMatrix(summaries []*Summary) *Matrix {
+	m := &Matrix{}
+	groups := make(map[string]*MatrixRow)
+	tests := make(map[string]*MatrixRow)
+	row := func(rows map[string]*MatrixRow, name string) *MatrixRow {
+		r, ok := rows[name]
+		if !ok {
+			r = &MatrixRow{Name: name, Cells: make([]*MatrixCell, len(summaries))}
+			for i := range r.Cells {
+				r.Cells[i] = &MatrixCell{}
+			}
+			rows[name] = r
+		}
+		return r
+	}
+
+	for i, s := range summaries {
+		m.Clients = append(m.Clients, s.Client)
+		for _, result := range s.Results() {
+			group := result.Method
+			if result.Tag != "" {
+				group += "_" + result.Tag
+			}
+			row(groups, group).Cells[i].add(result.Outcome)
+			row(tests, result.Name).Cells[i].add(result.Outcome)
+		}
+	}
+
+	m.Groups = sortedRows(groups)
+	m.Tests = sortedRows(tests)
+	return m
+}
+
+func sortedRows(rows map[string]*MatrixRow) []*MatrixRow {
+	result := make([]*MatrixRow, 0, len(rows))
+	for _, r := range rows {
+		result = append(result, r)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// WriteMarkdown writes the matrix as Markdown tables to `w`.
+func (m *Matrix) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("# Compatibility matrix\n\n## By method and tag\n\n")
+	m.writeMarkdownTable(&b, "Method_Tag", m.Groups)
+	b.WriteString("\n## By test case\n\n")
+	m.writeMarkdownTable(&b, "Test case", m.Tests)
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func (m *Matrix) writeMarkdownTable(b *strings.Builder, header string, rows []*MatrixRow) {
+	b.WriteString("| " + header + " |")
+	for _, client := range m.Clients {
+		b.WriteString(" " + client + " |")
+	}
+	b.WriteString("\n|---|" + strings.Repeat("---|", len(m.Clients)) + "\n")
+	for _, r := range rows {
+		b.WriteString("| " + r.Name + " |")
+		for _, c := range r.Cells {
+			b.WriteString(" " + c.Text() + " |")
+		}
+		b.WriteString("\n")
+	}
+}
+
+var matrixTemplate = template.Must(template.New("matrix").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Compatibility matrix</title>
+<style>
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 2px 8px; }
+td.pass { background: #d4edda; }
+td.fail { background: #f8d7da; }
+td.skip { background: #fff3cd; }
+</style>
+</head>
+<body>
+<h1>Compatibility matrix</h1>
+{{define "table"}}<table>
+<tr><th>{{.Header}}</th>{{range .Clients}}<th>{{.}}</th>{{end}}</tr>
+{{range .Rows}}<tr><td>{{.Name}}</td>{{range .Cells}}<td class="{{.Class}}">{{.Text}}</td>{{end}}</tr>
+{{end}}</table>{{end}}
+<h2>By method and tag</h2>
+{{template "table" .Groups}}
+<h2>By test case</h2>
+{{template "table" .Tests}}
+</body>
+</html>
+`))
+
+type htmlTable struct {
+	Header  string
+	Clients []string
+	Rows    []*MatrixRow
+}
+
+// WriteHTML writes the matrix as an HTML page to `w`.
+func (m *Matrix) WriteHTML(w io.Writer) error {
+	return matrixTemplate.Execute(w, struct{ Groups, Tests htmlTable }{
+		Groups: htmlTable{Header: "Method_Tag", Clients: m.Clients, Rows: m.Groups},
+		Tests:  htmlTable{Header: "Test case", Clients: m.Clients, Rows: m.Tests},
+	})
+}
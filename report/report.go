@@ -0,0 +1,172 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package report turns the output of `go test -json` on the test cases into
+// reports: a JUnit XML file for CI systems, a JSON summary keyed by the test
+// names, and a compatibility matrix that merges the summaries of several
+// clients.
+package report
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Outcomes of a test.
+const (
+	Pass = "pass"
+	Fail = "fail"
+	Skip = "skip"
+)
+
+// event is an event printed by `go test -json`, see https://pkg.go.dev/cmd/test2json.
+type event struct {
+	Time    time.Time
+	Action  string
+	Package string
+	Test    string
+	Elapsed float64 // Seconds
+	Output  string
+}
+
+// Result is the result of a top-level test case. The result of its subtests is included.
+type Result struct {
+	Name string `json:"-"` // The key of the result in Summary.Tests.
+	// Method, Tag and Description are parsed from the name of the test case following
+	// docs/test_case_naming.md. Method is the name without "Test" and Tag is "" if the test case
+	// doesn't follow the convention.
+	Method      string  `json:"method"`
+	Tag         string  `json:"tag,omitempty"`
+	Description string  `json:"description,omitempty"`
+	Outcome     string  `json:"outcome"`
+	Elapsed     float64 `json:"elapsed"` // Seconds
+	Output      string  `json:"output,omitempty"`
+}
+
+// Summary is the results of a run of the test cases against a client.
+type Summary struct {
+	Client string             `json:"client"`
+	Tests  map[string]*Result `json:"tests"`
+}
+
+// ParseName splits the test name `name`, e.g., "TestReadRows_Retry_StreamReset", into the method
+// name, the tag and the description. For the names not following the convention, the method name
+// is the name without "Test" and the others are "".
+func ParseName(name string) (method string, tag string, description string) {
+	name = strings.TrimPrefix(name, "Test")
+	parts := strings.SplitN(name, "_", 3)
+	if len(parts) < 3 {
+		return name, "", ""
+	}
+	return parts[0], parts[1], parts[2]
+}
+
+// Parse reads the output of `go test -json` from `r`, and returns the summary of the top-level
+// test cases for `client`. Lines that aren't JSON events, e.g., the build errors, are ignored.
+func Parse(r io.Reader, client string) (*Summary, error) {
+	summary := &Summary{Client: client, Tests: make(map[string]*Result)}
+	output := make(map[string]*strings.Builder) // Output of the top-level tests, including their subtests.
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 1024*1024), 16*1024*1024)
+	for scanner.Scan() {
+		var e event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil || e.Test == "" {
+			continue
+		}
+		name, _, _ := strings.Cut(e.Test, "/")
+		switch e.Action {
+		case "run":
+			if _, ok := output[name]; !ok {
+				output[name] = &strings.Builder{}
+			}
+		case "output":
+			if b, ok := output[name]; ok {
+				b.WriteString(e.Output)
+			}
+		case Pass, Fail, Skip:
+			if name != e.Test {
+				continue // The outcome of a subtest is part of its parent's.
+			}
+			method, tag, description := ParseName(name)
+			result := &Result{
+				Name:        name,
+				Method:      method,
+				Tag:         tag,
+				Description: description,
+				Outcome:     e.Action,
+				Elapsed:     e.Elapsed,
+			}
+			if e.Action != Pass && output[name] != nil {
+				result.Output = output[name].String()
+			}
+			summary.Tests[name] = result
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// Results returns the results of the summary sorted by name.
+func (s *Summary) Results() []*Result {
+	results := make([]*Result, 0, len(s.Tests))
+	for name, result := range s.Tests {
+		result.Name = name
+		results = append(results, result)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Name < results[j].Name })
+	return results
+}
+
+// Count returns the number of the results with `outcome`.
+func (s *Summary) Count(outcome string) int {
+	n := 0
+	for _, result := range s.Tests {
+		if result.Outcome == outcome {
+			n++
+		}
+	}
+	return n
+}
+
+// WriteJSON writes the summary as indented JSON to `w`.
+func (s *Summary) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+// ReadSummary reads a summary written by WriteJSON() from `file`.
+func ReadSummary(file string) (*Summary, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var s Summary
+	if err := json.Unmarshal(content, &s); err != nil {
+		return nil, fmt.Errorf("invalid summary %s: %v", file, err)
+	}
+	for name, result := range s.Tests {
+		result.Name = name
+	}
+	return &s, nil
+}
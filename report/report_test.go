@@ -0,0 +1,122 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package report
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const goTestJSON = `{"Action":"start","Package":"tests"}
+{"Action":"run","Package":"tests","Test":"TestReadRows_Retry_StreamReset"}
+{"Action":"output","Package":"tests","Test":"TestReadRows_Retry_StreamReset","Output":"=== RUN   TestReadRows_Retry_StreamReset\n"}
+{"Action":"pass","Package":"tests","Test":"TestReadRows_Retry_StreamReset","Elapsed":1.5}
+{"Action":"run","Package":"tests","Test":"TestReadRows_NoRetry_Acceptance"}
+{"Action":"run","Package":"tests","Test":"TestReadRows_NoRetry_Acceptance/two_rows"}
+{"Action":"output","Package":"tests","Test":"TestReadRows_NoRetry_Acceptance/two_rows","Output":"    rows mismatch\n"}
+{"Action":"fail","Package":"tests","Test":"TestReadRows_NoRetry_Acceptance/two_rows","Elapsed":0.1}
+{"Action":"fail","Package":"tests","Test":"TestReadRows_NoRetry_Acceptance","Elapsed":0.2}
+{"Action":"run","Package":"tests","Test":"TestMutateRow_Generic_Headers"}
+{"Action":"skip","Package":"tests","Test":"TestMutateRow_Generic_Headers","Elapsed":0}
+not a JSON line
+{"Action":"run","Package":"tests","Test":"TestScenarios"}
+{"Action":"pass","Package":"tests","Test":"TestScenarios","Elapsed":0.3}
+{"Action":"pass","Package":"tests","Elapsed":2.1}
+`
+
+func TestParseName(t *testing.T) {
+	method, tag, description := ParseName("TestReadRows_Retry_LastScannedRow_Reverse")
+	assert.Equal(t, "ReadRows", method)
+	assert.Equal(t, "Retry", tag)
+	assert.Equal(t, "LastScannedRow_Reverse", description)
+
+	method, tag, description = ParseName("TestScenarios")
+	assert.Equal(t, "Scenarios", method)
+	assert.Empty(t, tag)
+	assert.Empty(t, description)
+}
+
+func TestParse(t *testing.T) {
+	summary, err := Parse(strings.NewReader(goTestJSON), "go")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, "go", summary.Client)
+	assert.Len(t, summary.Tests, 4)
+	assert.Equal(t, 2, summary.Count(Pass))
+	assert.Equal(t, 1, summary.Count(Fail))
+	assert.Equal(t, 1, summary.Count(Skip))
+
+	failed := summary.Tests["TestReadRows_NoRetry_Acceptance"]
+	assert.Equal(t, "ReadRows", failed.Method)
+	assert.Equal(t, "NoRetry", failed.Tag)
+	assert.Equal(t, Fail, failed.Outcome)
+	assert.Contains(t, failed.Output, "rows mismatch", "the output of the subtests should be kept")
+	assert.Empty(t, summary.Tests["TestReadRows_Retry_StreamReset"].Output, "the output of passed tests should be dropped")
+}
+
+func TestWriteJUnit(t *testing.T) {
+	summary, _ := Parse(strings.NewReader(goTestJSON), "go")
+	var b strings.Builder
+	if !assert.NoError(t, summary.WriteJUnit(&b)) {
+		return
+	}
+
+	junit := b.String()
+	assert.Contains(t, junit, `<testsuite name="go" tests="4" failures="1" skipped="1" time="2.000">`)
+	assert.Contains(t, junit, `<testcase name="TestReadRows_Retry_StreamReset" classname="ReadRows.Retry" time="1.500"></testcase>`)
+	assert.Contains(t, junit, `<failure message="failed">`)
+	assert.Contains(t, junit, `<skipped message="skipped">`)
+}
+
+func TestMatrix(t *testing.T) {
+	goSummary, _ := Parse(strings.NewReader(goTestJSON), "go")
+	javaSummary := &Summary{Client: "java", Tests: map[string]*Result{
+		"TestReadRows_Retry_StreamReset":  {Method: "ReadRows", Tag: "Retry", Outcome: Pass},
+		"TestReadRows_NoRetry_Acceptance": {Method: "ReadRows", Tag: "NoRetry", Outcome: Pass},
+	}}
+	matrix := NewMatrix([]*Summary{goSummary, javaSummary})
+
+	assert.Equal(t, []string{"go", "java"}, matrix.Clients)
+	var groups []string
+	for _, row := range matrix.Groups {
+		groups = append(groups, row.Name)
+	}
+	assert.Equal(t, []string{"MutateRow_Generic", "ReadRows_NoRetry", "ReadRows_Retry", "Scenarios"}, groups)
+	assert.Equal(t, "fail", matrix.Groups[1].Cells[0].Text())
+	assert.Equal(t, "pass", matrix.Groups[1].Cells[1].Text())
+	assert.Equal(t, "-", matrix.Groups[0].Cells[1].Text(), "java didn't run the test")
+
+	var b strings.Builder
+	if !assert.NoError(t, matrix.WriteMarkdown(&b)) {
+		return
+	}
+	assert.Contains(t, b.String(), "| ReadRows_NoRetry | fail | pass |")
+
+	b.Reset()
+	if !assert.NoError(t, matrix.WriteHTML(&b)) {
+		return
+	}
+	assert.Contains(t, b.String(), `<td class="fail">fail</td><td class="pass">pass</td>`)
+}
+
+func TestMatrixCellText(t *testing.T) {
+	assert.Equal(t, "3/4 (1 fail)", (&MatrixCell{Pass: 3, Fail: 1}).Text())
+	assert.Equal(t, "1/3 (1 fail, 1 skip)", (&MatrixCell{Pass: 1, Fail: 1, Skip: 1}).Text())
+	assert.Equal(t, "2/2", (&MatrixCell{Pass: 2}).Text())
+}
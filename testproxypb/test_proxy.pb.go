@@ -21,12 +21,14 @@
 package testproxypb
 
 import (
+	adminpb "cloud.google.com/go/bigtable/admin/apiv2/adminpb"
 	bigtablepb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	status "google.golang.org/genproto/googleapis/rpc/status"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -123,8 +125,14 @@ type CreateClientRequest struct {
 	// so it is not recommended to use it with real credentials or outside testing
 	// contexts.
 	SecurityOptions *CreateClientRequest_SecurityOptions `protobuf:"bytes,8,opt,name=security_options,json=securityOptions,proto3" json:"security_options,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Optional "host:port" address of a Cloud Monitoring MetricService, which
+	// the client should export its built-in client-side metrics to, instead of
+	// Cloud Monitoring. The metrics must be exported at the latest when the
+	// client is closed. If empty, the client should not export the metrics to
+	// the test framework.
+	MetricsTarget string `protobuf:"bytes,9,opt,name=metrics_target,json=metricsTarget,proto3" json:"metrics_target,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateClientRequest) Reset() {
@@ -213,6 +221,13 @@ func (x *CreateClientRequest) GetSecurityOptions() *CreateClientRequest_Security
 	return nil
 }
 
+func (x *CreateClientRequest) GetMetricsTarget() string {
+	if x != nil {
+		return x.MetricsTarget
+	}
+	return ""
+}
+
 // Response from test proxy service for CreateClientRequest.
 type CreateClientResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -428,7 +443,12 @@ type ReadRowRequest struct {
 	// The row key of the target row.
 	RowKey string `protobuf:"bytes,2,opt,name=row_key,json=rowKey,proto3" json:"row_key,omitempty"`
 	// The row filter to be applied to the target row.
-	Filter        *bigtablepb.RowFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	Filter *bigtablepb.RowFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,5,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -491,6 +511,13 @@ func (x *ReadRowRequest) GetFilter() *bigtablepb.RowFilter {
 	return nil
 }
 
+func (x *ReadRowRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
 // Response from test proxy service for ReadRowRequest or
 // ReadModifyWriteRowRequest.
 type RowResult struct {
@@ -557,8 +584,13 @@ type ReadRowsRequest struct {
 	// The streaming read can be canceled before all items are seen.
 	// Has no effect if non-positive.
 	CancelAfterRows int32 `protobuf:"varint,3,opt,name=cancel_after_rows,json=cancelAfterRows,proto3" json:"cancel_after_rows,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,4,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ReadRowsRequest) Reset() {
@@ -612,6 +644,13 @@ func (x *ReadRowsRequest) GetCancelAfterRows() int32 {
 	return 0
 }
 
+func (x *ReadRowsRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
 // Response from test proxy service for ReadRowsRequest.
 type RowsResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -673,7 +712,12 @@ type MutateRowRequest struct {
 	// The ID of the target client object.
 	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	// The raw request to the Bigtable server.
-	Request       *bigtablepb.MutateRowRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Request *bigtablepb.MutateRowRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,3,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -722,6 +766,13 @@ func (x *MutateRowRequest) GetRequest() *bigtablepb.MutateRowRequest {
 	return nil
 }
 
+func (x *MutateRowRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
 // Response from test proxy service for MutateRowRequest.
 type MutateRowResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -774,7 +825,12 @@ type MutateRowsRequest struct {
 	// The ID of the target client object.
 	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	// The raw request to the Bigtable server.
-	Request       *bigtablepb.MutateRowsRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	Request *bigtablepb.MutateRowsRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,3,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -823,6 +879,13 @@ func (x *MutateRowsRequest) GetRequest() *bigtablepb.MutateRowsRequest {
 	return nil
 }
 
+func (x *MutateRowsRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
 // Response from test proxy service for MutateRowsRequest.
 type MutateRowsResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -879,31 +942,42 @@ func (x *MutateRowsResult) GetEntries() []*bigtablepb.MutateRowsResponse_Entry {
 	return nil
 }
 
-// Request to test proxy service to check and mutate a row.
-type CheckAndMutateRowRequest struct {
+// Settings of a mutation batcher. A threshold of 0 takes the default of the
+// client binding.
+type BatcherSettings struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the target client object.
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// The raw request to the Bigtable server.
-	Request       *bigtablepb.CheckAndMutateRowRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *CheckAndMutateRowRequest) Reset() {
-	*x = CheckAndMutateRowRequest{}
+	// A batch is sent once it has this many entries.
+	MaxBatchEntries int64 `protobuf:"varint,1,opt,name=max_batch_entries,json=maxBatchEntries,proto3" json:"max_batch_entries,omitempty"`
+	// A batch is sent once the serialized size of its entries, i.e., the
+	// MutateRowsRequest.Entry messages, reaches this many bytes.
+	MaxBatchBytes int64 `protobuf:"varint,2,opt,name=max_batch_bytes,json=maxBatchBytes,proto3" json:"max_batch_bytes,omitempty"`
+	// A non-empty batch is sent at the latest this long after its first entry
+	// is added. If unset, the default of the client binding is used.
+	FlushInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=flush_interval,json=flushInterval,proto3" json:"flush_interval,omitempty"`
+	// Flow control: adding entries waits while this many entries are
+	// outstanding, i.e., added but not done yet, including those in flight.
+	MaxOutstandingEntries int64 `protobuf:"varint,4,opt,name=max_outstanding_entries,json=maxOutstandingEntries,proto3" json:"max_outstanding_entries,omitempty"`
+	// Flow control: adding entries waits while the outstanding entries have
+	// this many bytes.
+	MaxOutstandingBytes int64 `protobuf:"varint,5,opt,name=max_outstanding_bytes,json=maxOutstandingBytes,proto3" json:"max_outstanding_bytes,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *BatcherSettings) Reset() {
+	*x = BatcherSettings{}
 	mi := &file_test_proxy_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAndMutateRowRequest) String() string {
+func (x *BatcherSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAndMutateRowRequest) ProtoMessage() {}
+func (*BatcherSettings) ProtoMessage() {}
 
-func (x *CheckAndMutateRowRequest) ProtoReflect() protoreflect.Message {
+func (x *BatcherSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_test_proxy_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -915,50 +989,77 @@ func (x *CheckAndMutateRowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAndMutateRowRequest.ProtoReflect.Descriptor instead.
-func (*CheckAndMutateRowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatcherSettings.ProtoReflect.Descriptor instead.
+func (*BatcherSettings) Descriptor() ([]byte, []int) {
 	return file_test_proxy_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *CheckAndMutateRowRequest) GetClientId() string {
+func (x *BatcherSettings) GetMaxBatchEntries() int64 {
 	if x != nil {
-		return x.ClientId
+		return x.MaxBatchEntries
 	}
-	return ""
+	return 0
 }
 
-func (x *CheckAndMutateRowRequest) GetRequest() *bigtablepb.CheckAndMutateRowRequest {
+func (x *BatcherSettings) GetMaxBatchBytes() int64 {
 	if x != nil {
-		return x.Request
+		return x.MaxBatchBytes
+	}
+	return 0
+}
+
+func (x *BatcherSettings) GetFlushInterval() *durationpb.Duration {
+	if x != nil {
+		return x.FlushInterval
 	}
 	return nil
 }
 
-// Response from test proxy service for CheckAndMutateRowRequest.
-type CheckAndMutateRowResult struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The RPC status from the client binding.
-	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// The raw response from the Bigtable server.
-	Result        *bigtablepb.CheckAndMutateRowResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BatcherSettings) GetMaxOutstandingEntries() int64 {
+	if x != nil {
+		return x.MaxOutstandingEntries
+	}
+	return 0
 }
 
-func (x *CheckAndMutateRowResult) Reset() {
-	*x = CheckAndMutateRowResult{}
+func (x *BatcherSettings) GetMaxOutstandingBytes() int64 {
+	if x != nil {
+		return x.MaxOutstandingBytes
+	}
+	return 0
+}
+
+// Request to test proxy service to create a mutation batcher.
+type CreateBatcherRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The ID of the batcher, which is unique within the client.
+	BatcherId string `protobuf:"bytes,2,opt,name=batcher_id,json=batcherId,proto3" json:"batcher_id,omitempty"`
+	// The table to write, "projects/<p>/instances/<i>/tables/<t>".
+	TableName string `protobuf:"bytes,3,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	// Optional authorized view to write instead of the table,
+	// "projects/<p>/instances/<i>/tables/<t>/authorizedViews/<v>".
+	AuthorizedViewName string           `protobuf:"bytes,4,opt,name=authorized_view_name,json=authorizedViewName,proto3" json:"authorized_view_name,omitempty"`
+	Settings           *BatcherSettings `protobuf:"bytes,5,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreateBatcherRequest) Reset() {
+	*x = CreateBatcherRequest{}
 	mi := &file_test_proxy_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckAndMutateRowResult) String() string {
+func (x *CreateBatcherRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckAndMutateRowResult) ProtoMessage() {}
+func (*CreateBatcherRequest) ProtoMessage() {}
 
-func (x *CheckAndMutateRowResult) ProtoReflect() protoreflect.Message {
+func (x *CreateBatcherRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_test_proxy_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -970,50 +1071,67 @@ func (x *CheckAndMutateRowResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckAndMutateRowResult.ProtoReflect.Descriptor instead.
-func (*CheckAndMutateRowResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBatcherRequest.ProtoReflect.Descriptor instead.
+func (*CreateBatcherRequest) Descriptor() ([]byte, []int) {
 	return file_test_proxy_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *CheckAndMutateRowResult) GetStatus() *status.Status {
+func (x *CreateBatcherRequest) GetClientId() string {
 	if x != nil {
-		return x.Status
+		return x.ClientId
 	}
-	return nil
+	return ""
 }
 
-func (x *CheckAndMutateRowResult) GetResult() *bigtablepb.CheckAndMutateRowResponse {
+func (x *CreateBatcherRequest) GetBatcherId() string {
 	if x != nil {
-		return x.Result
+		return x.BatcherId
+	}
+	return ""
+}
+
+func (x *CreateBatcherRequest) GetTableName() string {
+	if x != nil {
+		return x.TableName
+	}
+	return ""
+}
+
+func (x *CreateBatcherRequest) GetAuthorizedViewName() string {
+	if x != nil {
+		return x.AuthorizedViewName
+	}
+	return ""
+}
+
+func (x *CreateBatcherRequest) GetSettings() *BatcherSettings {
+	if x != nil {
+		return x.Settings
 	}
 	return nil
 }
 
-// Request to test proxy service to sample row keys.
-type SampleRowKeysRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the target client object.
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// The raw request to the Bigtable server.
-	Request       *bigtablepb.SampleRowKeysRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+// Response from test proxy service for CreateBatcherRequest.
+type CreateBatcherResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SampleRowKeysRequest) Reset() {
-	*x = SampleRowKeysRequest{}
+func (x *CreateBatcherResponse) Reset() {
+	*x = CreateBatcherResponse{}
 	mi := &file_test_proxy_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SampleRowKeysRequest) String() string {
+func (x *CreateBatcherResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SampleRowKeysRequest) ProtoMessage() {}
+func (*CreateBatcherResponse) ProtoMessage() {}
 
-func (x *SampleRowKeysRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBatcherResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_test_proxy_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,50 +1143,38 @@ func (x *SampleRowKeysRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SampleRowKeysRequest.ProtoReflect.Descriptor instead.
-func (*SampleRowKeysRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBatcherResponse.ProtoReflect.Descriptor instead.
+func (*CreateBatcherResponse) Descriptor() ([]byte, []int) {
 	return file_test_proxy_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *SampleRowKeysRequest) GetClientId() string {
-	if x != nil {
-		return x.ClientId
-	}
-	return ""
-}
-
-func (x *SampleRowKeysRequest) GetRequest() *bigtablepb.SampleRowKeysRequest {
-	if x != nil {
-		return x.Request
-	}
-	return nil
-}
-
-// Response from test proxy service for SampleRowKeysRequest.
-type SampleRowKeysResult struct {
+// Request to test proxy service to add entries to a mutation batcher.
+type AddBatcherEntriesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The RPC status from the client binding.
-	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// The raw responses from the Bigtable server.
-	Samples       []*bigtablepb.SampleRowKeysResponse `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The ID of the target batcher.
+	BatcherId string `protobuf:"bytes,2,opt,name=batcher_id,json=batcherId,proto3" json:"batcher_id,omitempty"`
+	// The entries to add, in order.
+	Entries       []*bigtablepb.MutateRowsRequest_Entry `protobuf:"bytes,3,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SampleRowKeysResult) Reset() {
-	*x = SampleRowKeysResult{}
+func (x *AddBatcherEntriesRequest) Reset() {
+	*x = AddBatcherEntriesRequest{}
 	mi := &file_test_proxy_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SampleRowKeysResult) String() string {
+func (x *AddBatcherEntriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SampleRowKeysResult) ProtoMessage() {}
+func (*AddBatcherEntriesRequest) ProtoMessage() {}
 
-func (x *SampleRowKeysResult) ProtoReflect() protoreflect.Message {
+func (x *AddBatcherEntriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_test_proxy_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1080,50 +1186,57 @@ func (x *SampleRowKeysResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SampleRowKeysResult.ProtoReflect.Descriptor instead.
-func (*SampleRowKeysResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddBatcherEntriesRequest.ProtoReflect.Descriptor instead.
+func (*AddBatcherEntriesRequest) Descriptor() ([]byte, []int) {
 	return file_test_proxy_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *SampleRowKeysResult) GetStatus() *status.Status {
+func (x *AddBatcherEntriesRequest) GetClientId() string {
 	if x != nil {
-		return x.Status
+		return x.ClientId
 	}
-	return nil
+	return ""
 }
 
-func (x *SampleRowKeysResult) GetSamples() []*bigtablepb.SampleRowKeysResponse {
+func (x *AddBatcherEntriesRequest) GetBatcherId() string {
 	if x != nil {
-		return x.Samples
+		return x.BatcherId
+	}
+	return ""
+}
+
+func (x *AddBatcherEntriesRequest) GetEntries() []*bigtablepb.MutateRowsRequest_Entry {
+	if x != nil {
+		return x.Entries
 	}
 	return nil
 }
 
-// Request to test proxy service to read modify write a row.
-type ReadModifyWriteRowRequest struct {
+// Response from test proxy service for AddBatcherEntriesRequest, which is
+// returned once the batcher has accepted all the entries, i.e., after the flow
+// control lets them in. The entries may still be pending or in flight.
+type AddBatcherEntriesResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The ID of the target client object.
-	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// The raw request to the Bigtable server.
-	Request       *bigtablepb.ReadModifyWriteRowRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// The RPC status from the client binding, e.g., if the batcher is closed.
+	Status        *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadModifyWriteRowRequest) Reset() {
-	*x = ReadModifyWriteRowRequest{}
+func (x *AddBatcherEntriesResult) Reset() {
+	*x = AddBatcherEntriesResult{}
 	mi := &file_test_proxy_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadModifyWriteRowRequest) String() string {
+func (x *AddBatcherEntriesResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadModifyWriteRowRequest) ProtoMessage() {}
+func (*AddBatcherEntriesResult) ProtoMessage() {}
 
-func (x *ReadModifyWriteRowRequest) ProtoReflect() protoreflect.Message {
+func (x *AddBatcherEntriesResult) ProtoReflect() protoreflect.Message {
 	mi := &file_test_proxy_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1135,50 +1248,43 @@ func (x *ReadModifyWriteRowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadModifyWriteRowRequest.ProtoReflect.Descriptor instead.
-func (*ReadModifyWriteRowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddBatcherEntriesResult.ProtoReflect.Descriptor instead.
+func (*AddBatcherEntriesResult) Descriptor() ([]byte, []int) {
 	return file_test_proxy_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ReadModifyWriteRowRequest) GetClientId() string {
-	if x != nil {
-		return x.ClientId
-	}
-	return ""
-}
-
-func (x *ReadModifyWriteRowRequest) GetRequest() *bigtablepb.ReadModifyWriteRowRequest {
+func (x *AddBatcherEntriesResult) GetStatus() *status.Status {
 	if x != nil {
-		return x.Request
+		return x.Status
 	}
 	return nil
 }
 
-// Request to test proxy service to execute a query.
-type ExecuteQueryRequest struct {
+// Request to test proxy service to flush a mutation batcher.
+type FlushBatcherRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The ID of the target client object.
 	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
-	// The raw request to the Bigtable server.
-	Request       *bigtablepb.ExecuteQueryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// The ID of the target batcher.
+	BatcherId     string `protobuf:"bytes,2,opt,name=batcher_id,json=batcherId,proto3" json:"batcher_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecuteQueryRequest) Reset() {
-	*x = ExecuteQueryRequest{}
+func (x *FlushBatcherRequest) Reset() {
+	*x = FlushBatcherRequest{}
 	mi := &file_test_proxy_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteQueryRequest) String() string {
+func (x *FlushBatcherRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteQueryRequest) ProtoMessage() {}
+func (*FlushBatcherRequest) ProtoMessage() {}
 
-func (x *ExecuteQueryRequest) ProtoReflect() protoreflect.Message {
+func (x *FlushBatcherRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_test_proxy_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1190,52 +1296,51 @@ func (x *ExecuteQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteQueryRequest.ProtoReflect.Descriptor instead.
-func (*ExecuteQueryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FlushBatcherRequest.ProtoReflect.Descriptor instead.
+func (*FlushBatcherRequest) Descriptor() ([]byte, []int) {
 	return file_test_proxy_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ExecuteQueryRequest) GetClientId() string {
+func (x *FlushBatcherRequest) GetClientId() string {
 	if x != nil {
 		return x.ClientId
 	}
 	return ""
 }
 
-func (x *ExecuteQueryRequest) GetRequest() *bigtablepb.ExecuteQueryRequest {
+func (x *FlushBatcherRequest) GetBatcherId() string {
 	if x != nil {
-		return x.Request
+		return x.BatcherId
 	}
-	return nil
+	return ""
 }
 
-// Response from test proxy service for ExecuteQueryRequest.
-type ExecuteQueryResult struct {
+// Request to test proxy service to close a mutation batcher, which flushes
+// it and makes it not accept new entries.
+type CloseBatcherRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The RPC status from the client binding.
-	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// Name and type information for the query result.
-	Metadata *ResultSetMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	// Encoded version of the ResultSet. Should not contain type information.
-	Rows          []*SqlRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The ID of the target batcher.
+	BatcherId     string `protobuf:"bytes,2,opt,name=batcher_id,json=batcherId,proto3" json:"batcher_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExecuteQueryResult) Reset() {
-	*x = ExecuteQueryResult{}
+func (x *CloseBatcherRequest) Reset() {
+	*x = CloseBatcherRequest{}
 	mi := &file_test_proxy_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExecuteQueryResult) String() string {
+func (x *CloseBatcherRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteQueryResult) ProtoMessage() {}
+func (*CloseBatcherRequest) ProtoMessage() {}
 
-func (x *ExecuteQueryResult) ProtoReflect() protoreflect.Message {
+func (x *CloseBatcherRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_test_proxy_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1247,55 +1352,55 @@ func (x *ExecuteQueryResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExecuteQueryResult.ProtoReflect.Descriptor instead.
-func (*ExecuteQueryResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseBatcherRequest.ProtoReflect.Descriptor instead.
+func (*CloseBatcherRequest) Descriptor() ([]byte, []int) {
 	return file_test_proxy_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ExecuteQueryResult) GetStatus() *status.Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
-}
-
-func (x *ExecuteQueryResult) GetMetadata() *ResultSetMetadata {
+func (x *CloseBatcherRequest) GetClientId() string {
 	if x != nil {
-		return x.Metadata
+		return x.ClientId
 	}
-	return nil
+	return ""
 }
 
-func (x *ExecuteQueryResult) GetRows() []*SqlRow {
+func (x *CloseBatcherRequest) GetBatcherId() string {
 	if x != nil {
-		return x.Rows
+		return x.BatcherId
 	}
-	return nil
+	return ""
 }
 
-// Schema information for the query result.
-type ResultSetMetadata struct {
+// Response from test proxy service for FlushBatcherRequest or
+// CloseBatcherRequest, which is returned once all the entries added so far
+// are done.
+type BatcherResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Column metadata for each column inthe query result.
-	Columns       []*bigtablepb.ColumnMetadata `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	// The RPC status from the client binding, corresponding to the flush or the
+	// close itself rather than the entries.
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The results corresponding to the failed entries that aren't reported by an
+	// earlier flush. The index of an entry counts all the entries added to the
+	// batcher, starting from 0.
+	Entries       []*bigtablepb.MutateRowsResponse_Entry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResultSetMetadata) Reset() {
-	*x = ResultSetMetadata{}
+func (x *BatcherResult) Reset() {
+	*x = BatcherResult{}
 	mi := &file_test_proxy_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResultSetMetadata) String() string {
+func (x *BatcherResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResultSetMetadata) ProtoMessage() {}
+func (*BatcherResult) ProtoMessage() {}
 
-func (x *ResultSetMetadata) ProtoReflect() protoreflect.Message {
+func (x *BatcherResult) ProtoReflect() protoreflect.Message {
 	mi := &file_test_proxy_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1307,42 +1412,1255 @@ func (x *ResultSetMetadata) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResultSetMetadata.ProtoReflect.Descriptor instead.
-func (*ResultSetMetadata) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatcherResult.ProtoReflect.Descriptor instead.
+func (*BatcherResult) Descriptor() ([]byte, []int) {
 	return file_test_proxy_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ResultSetMetadata) GetColumns() []*bigtablepb.ColumnMetadata {
+func (x *BatcherResult) GetStatus() *status.Status {
 	if x != nil {
-		return x.Columns
+		return x.Status
 	}
 	return nil
 }
 
-// Representation of a single row in the query result.
-type SqlRow struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Columnar values returned by the query.
-	Values        []*bigtablepb.Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *BatcherResult) GetEntries() []*bigtablepb.MutateRowsResponse_Entry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Request to test proxy service to check and mutate a row.
+type CheckAndMutateRowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable server.
+	Request *bigtablepb.CheckAndMutateRowRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,3,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAndMutateRowRequest) Reset() {
+	*x = CheckAndMutateRowRequest{}
+	mi := &file_test_proxy_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAndMutateRowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAndMutateRowRequest) ProtoMessage() {}
+
+func (x *CheckAndMutateRowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAndMutateRowRequest.ProtoReflect.Descriptor instead.
+func (*CheckAndMutateRowRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CheckAndMutateRowRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CheckAndMutateRowRequest) GetRequest() *bigtablepb.CheckAndMutateRowRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *CheckAndMutateRowRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
+// Response from test proxy service for CheckAndMutateRowRequest.
+type CheckAndMutateRowResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The raw response from the Bigtable server.
+	Result        *bigtablepb.CheckAndMutateRowResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAndMutateRowResult) Reset() {
+	*x = CheckAndMutateRowResult{}
+	mi := &file_test_proxy_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAndMutateRowResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAndMutateRowResult) ProtoMessage() {}
+
+func (x *CheckAndMutateRowResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAndMutateRowResult.ProtoReflect.Descriptor instead.
+func (*CheckAndMutateRowResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *CheckAndMutateRowResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CheckAndMutateRowResult) GetResult() *bigtablepb.CheckAndMutateRowResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Request to test proxy service to sample row keys.
+type SampleRowKeysRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable server.
+	Request *bigtablepb.SampleRowKeysRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,3,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SampleRowKeysRequest) Reset() {
+	*x = SampleRowKeysRequest{}
+	mi := &file_test_proxy_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SampleRowKeysRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleRowKeysRequest) ProtoMessage() {}
+
+func (x *SampleRowKeysRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleRowKeysRequest.ProtoReflect.Descriptor instead.
+func (*SampleRowKeysRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SampleRowKeysRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *SampleRowKeysRequest) GetRequest() *bigtablepb.SampleRowKeysRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *SampleRowKeysRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
+// Response from test proxy service for SampleRowKeysRequest.
+type SampleRowKeysResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The raw responses from the Bigtable server.
+	Samples       []*bigtablepb.SampleRowKeysResponse `protobuf:"bytes,2,rep,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SampleRowKeysResult) Reset() {
+	*x = SampleRowKeysResult{}
+	mi := &file_test_proxy_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SampleRowKeysResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleRowKeysResult) ProtoMessage() {}
+
+func (x *SampleRowKeysResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleRowKeysResult.ProtoReflect.Descriptor instead.
+func (*SampleRowKeysResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SampleRowKeysResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SampleRowKeysResult) GetSamples() []*bigtablepb.SampleRowKeysResponse {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+// Request to test proxy service to read modify write a row.
+type ReadModifyWriteRowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable server.
+	Request *bigtablepb.ReadModifyWriteRowRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,3,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadModifyWriteRowRequest) Reset() {
+	*x = ReadModifyWriteRowRequest{}
+	mi := &file_test_proxy_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadModifyWriteRowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadModifyWriteRowRequest) ProtoMessage() {}
+
+func (x *ReadModifyWriteRowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadModifyWriteRowRequest.ProtoReflect.Descriptor instead.
+func (*ReadModifyWriteRowRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReadModifyWriteRowRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ReadModifyWriteRowRequest) GetRequest() *bigtablepb.ReadModifyWriteRowRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ReadModifyWriteRowRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
+// Request to test proxy service to execute a query.
+type ExecuteQueryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable server.
+	Request *bigtablepb.ExecuteQueryRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,3,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteQueryRequest) Reset() {
+	*x = ExecuteQueryRequest{}
+	mi := &file_test_proxy_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteQueryRequest) ProtoMessage() {}
+
+func (x *ExecuteQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteQueryRequest.ProtoReflect.Descriptor instead.
+func (*ExecuteQueryRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ExecuteQueryRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ExecuteQueryRequest) GetRequest() *bigtablepb.ExecuteQueryRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ExecuteQueryRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
+// Response from test proxy service for ExecuteQueryRequest.
+type ExecuteQueryResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Name and type information for the query result.
+	Metadata *ResultSetMetadata `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Encoded version of the ResultSet. Should not contain type information.
+	Rows          []*SqlRow `protobuf:"bytes,3,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExecuteQueryResult) Reset() {
+	*x = ExecuteQueryResult{}
+	mi := &file_test_proxy_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExecuteQueryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteQueryResult) ProtoMessage() {}
+
+func (x *ExecuteQueryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExecuteQueryResult.ProtoReflect.Descriptor instead.
+func (*ExecuteQueryResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ExecuteQueryResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ExecuteQueryResult) GetMetadata() *ResultSetMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ExecuteQueryResult) GetRows() []*SqlRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+// Schema information for the query result.
+type ResultSetMetadata struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Column metadata for each column inthe query result.
+	Columns       []*bigtablepb.ColumnMetadata `protobuf:"bytes,1,rep,name=columns,proto3" json:"columns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResultSetMetadata) Reset() {
+	*x = ResultSetMetadata{}
+	mi := &file_test_proxy_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResultSetMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResultSetMetadata) ProtoMessage() {}
+
+func (x *ResultSetMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResultSetMetadata.ProtoReflect.Descriptor instead.
+func (*ResultSetMetadata) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ResultSetMetadata) GetColumns() []*bigtablepb.ColumnMetadata {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+// Representation of a single row in the query result.
+type SqlRow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Columnar values returned by the query.
+	Values        []*bigtablepb.Value `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SqlRow) Reset() {
+	*x = SqlRow{}
+	mi := &file_test_proxy_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SqlRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SqlRow) ProtoMessage() {}
+
+func (x *SqlRow) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SqlRow.ProtoReflect.Descriptor instead.
+func (*SqlRow) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SqlRow) GetValues() []*bigtablepb.Value {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+// Request to test proxy service to create a table.
+type CreateTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable table admin server.
+	Request       *adminpb.CreateTableRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTableRequest) Reset() {
+	*x = CreateTableRequest{}
+	mi := &file_test_proxy_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTableRequest) ProtoMessage() {}
+
+func (x *CreateTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTableRequest.ProtoReflect.Descriptor instead.
+func (*CreateTableRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreateTableRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CreateTableRequest) GetRequest() *adminpb.CreateTableRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Response from test proxy service for CreateTableRequest or
+// ModifyColumnFamiliesRequest.
+type TableResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The table returned by the Bigtable table admin server.
+	Table         *adminpb.Table `protobuf:"bytes,2,opt,name=table,proto3" json:"table,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TableResult) Reset() {
+	*x = TableResult{}
+	mi := &file_test_proxy_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TableResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableResult) ProtoMessage() {}
+
+func (x *TableResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TableResult.ProtoReflect.Descriptor instead.
+func (*TableResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TableResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *TableResult) GetTable() *adminpb.Table {
+	if x != nil {
+		return x.Table
+	}
+	return nil
+}
+
+// Request to test proxy service to delete a table.
+type DeleteTableRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable table admin server.
+	Request       *adminpb.DeleteTableRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableRequest) Reset() {
+	*x = DeleteTableRequest{}
+	mi := &file_test_proxy_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableRequest) ProtoMessage() {}
+
+func (x *DeleteTableRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTableRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DeleteTableRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *DeleteTableRequest) GetRequest() *adminpb.DeleteTableRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Response from test proxy service for DeleteTableRequest.
+type DeleteTableResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status        *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTableResult) Reset() {
+	*x = DeleteTableResult{}
+	mi := &file_test_proxy_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTableResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTableResult) ProtoMessage() {}
+
+func (x *DeleteTableResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTableResult.ProtoReflect.Descriptor instead.
+func (*DeleteTableResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteTableResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Request to test proxy service to modify the column families of a table.
+type ModifyColumnFamiliesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable table admin server.
+	Request       *adminpb.ModifyColumnFamiliesRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModifyColumnFamiliesRequest) Reset() {
+	*x = ModifyColumnFamiliesRequest{}
+	mi := &file_test_proxy_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModifyColumnFamiliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyColumnFamiliesRequest) ProtoMessage() {}
+
+func (x *ModifyColumnFamiliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyColumnFamiliesRequest.ProtoReflect.Descriptor instead.
+func (*ModifyColumnFamiliesRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ModifyColumnFamiliesRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ModifyColumnFamiliesRequest) GetRequest() *adminpb.ModifyColumnFamiliesRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Request to test proxy service to drop a row range of a table.
+type DropRowRangeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable table admin server.
+	Request       *adminpb.DropRowRangeRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropRowRangeRequest) Reset() {
+	*x = DropRowRangeRequest{}
+	mi := &file_test_proxy_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropRowRangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropRowRangeRequest) ProtoMessage() {}
+
+func (x *DropRowRangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropRowRangeRequest.ProtoReflect.Descriptor instead.
+func (*DropRowRangeRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DropRowRangeRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *DropRowRangeRequest) GetRequest() *adminpb.DropRowRangeRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Response from test proxy service for DropRowRangeRequest.
+type DropRowRangeResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status        *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DropRowRangeResult) Reset() {
+	*x = DropRowRangeResult{}
+	mi := &file_test_proxy_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DropRowRangeResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DropRowRangeResult) ProtoMessage() {}
+
+func (x *DropRowRangeResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DropRowRangeResult.ProtoReflect.Descriptor instead.
+func (*DropRowRangeResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DropRowRangeResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Request to test proxy service to generate a consistency token for a table.
+type GenerateConsistencyTokenRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable table admin server.
+	Request       *adminpb.GenerateConsistencyTokenRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateConsistencyTokenRequest) Reset() {
+	*x = GenerateConsistencyTokenRequest{}
+	mi := &file_test_proxy_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateConsistencyTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConsistencyTokenRequest) ProtoMessage() {}
+
+func (x *GenerateConsistencyTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConsistencyTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateConsistencyTokenRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GenerateConsistencyTokenRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *GenerateConsistencyTokenRequest) GetRequest() *adminpb.GenerateConsistencyTokenRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Response from test proxy service for GenerateConsistencyTokenRequest.
+type GenerateConsistencyTokenResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The raw response from the Bigtable table admin server.
+	Result        *adminpb.GenerateConsistencyTokenResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GenerateConsistencyTokenResult) Reset() {
+	*x = GenerateConsistencyTokenResult{}
+	mi := &file_test_proxy_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GenerateConsistencyTokenResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateConsistencyTokenResult) ProtoMessage() {}
+
+func (x *GenerateConsistencyTokenResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateConsistencyTokenResult.ProtoReflect.Descriptor instead.
+func (*GenerateConsistencyTokenResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GenerateConsistencyTokenResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GenerateConsistencyTokenResult) GetResult() *adminpb.GenerateConsistencyTokenResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Request to test proxy service to check the replication consistency of a
+// table.
+type CheckConsistencyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable table admin server.
+	Request       *adminpb.CheckConsistencyRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckConsistencyRequest) Reset() {
+	*x = CheckConsistencyRequest{}
+	mi := &file_test_proxy_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConsistencyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyRequest) ProtoMessage() {}
+
+func (x *CheckConsistencyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyRequest.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CheckConsistencyRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *CheckConsistencyRequest) GetRequest() *adminpb.CheckConsistencyRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+// Response from test proxy service for CheckConsistencyRequest.
+type CheckConsistencyResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The raw response from the Bigtable table admin server.
+	Result        *adminpb.CheckConsistencyResponse `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckConsistencyResult) Reset() {
+	*x = CheckConsistencyResult{}
+	mi := &file_test_proxy_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConsistencyResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConsistencyResult) ProtoMessage() {}
+
+func (x *CheckConsistencyResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConsistencyResult.ProtoReflect.Descriptor instead.
+func (*CheckConsistencyResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CheckConsistencyResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *CheckConsistencyResult) GetResult() *adminpb.CheckConsistencyResponse {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// Request to test proxy service to read a change stream.
+type ReadChangeStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The ID of the target client object.
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// The raw request to the Bigtable server.
+	Request *bigtablepb.ReadChangeStreamRequest `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	// The streaming read can be canceled before all records are seen.
+	// Has no effect if non-positive.
+	CancelAfterRecords int32 `protobuf:"varint,3,opt,name=cancel_after_records,json=cancelAfterRecords,proto3" json:"cancel_after_records,omitempty"`
+	// Optional W3C trace context of the operation, e.g.,
+	// "00-<trace-id>-<span-id>-01". If set, the client should trace the
+	// operation as a child of this span, and propagate the trace to the server
+	// in every attempt.
+	Traceparent   string `protobuf:"bytes,4,opt,name=traceparent,proto3" json:"traceparent,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadChangeStreamRequest) Reset() {
+	*x = ReadChangeStreamRequest{}
+	mi := &file_test_proxy_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadChangeStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadChangeStreamRequest) ProtoMessage() {}
+
+func (x *ReadChangeStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *SqlRow) Reset() {
-	*x = SqlRow{}
-	mi := &file_test_proxy_proto_msgTypes[22]
+// Deprecated: Use ReadChangeStreamRequest.ProtoReflect.Descriptor instead.
+func (*ReadChangeStreamRequest) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ReadChangeStreamRequest) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ReadChangeStreamRequest) GetRequest() *bigtablepb.ReadChangeStreamRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *ReadChangeStreamRequest) GetCancelAfterRecords() int32 {
+	if x != nil {
+		return x.CancelAfterRecords
+	}
+	return 0
+}
+
+func (x *ReadChangeStreamRequest) GetTraceparent() string {
+	if x != nil {
+		return x.Traceparent
+	}
+	return ""
+}
+
+// A change stream record decoded by the client binding.
+type ChangeStreamRecord struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The record type.
+	//
+	// Types that are valid to be assigned to Record:
+	//
+	//	*ChangeStreamRecord_Mutation
+	//	*ChangeStreamRecord_Heartbeat
+	//	*ChangeStreamRecord_CloseStream
+	Record        isChangeStreamRecord_Record `protobuf_oneof:"record"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeStreamRecord) Reset() {
+	*x = ChangeStreamRecord{}
+	mi := &file_test_proxy_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SqlRow) String() string {
+func (x *ChangeStreamRecord) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SqlRow) ProtoMessage() {}
+func (*ChangeStreamRecord) ProtoMessage() {}
 
-func (x *SqlRow) ProtoReflect() protoreflect.Message {
-	mi := &file_test_proxy_proto_msgTypes[22]
+func (x *ChangeStreamRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1353,14 +2671,121 @@ func (x *SqlRow) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SqlRow.ProtoReflect.Descriptor instead.
-func (*SqlRow) Descriptor() ([]byte, []int) {
-	return file_test_proxy_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ChangeStreamRecord.ProtoReflect.Descriptor instead.
+func (*ChangeStreamRecord) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *SqlRow) GetValues() []*bigtablepb.Value {
+func (x *ChangeStreamRecord) GetRecord() isChangeStreamRecord_Record {
 	if x != nil {
-		return x.Values
+		return x.Record
+	}
+	return nil
+}
+
+func (x *ChangeStreamRecord) GetMutation() *ChangeStreamRecord_ChangeStreamMutation {
+	if x != nil {
+		if x, ok := x.Record.(*ChangeStreamRecord_Mutation); ok {
+			return x.Mutation
+		}
+	}
+	return nil
+}
+
+func (x *ChangeStreamRecord) GetHeartbeat() *bigtablepb.ReadChangeStreamResponse_Heartbeat {
+	if x != nil {
+		if x, ok := x.Record.(*ChangeStreamRecord_Heartbeat); ok {
+			return x.Heartbeat
+		}
+	}
+	return nil
+}
+
+func (x *ChangeStreamRecord) GetCloseStream() *bigtablepb.ReadChangeStreamResponse_CloseStream {
+	if x != nil {
+		if x, ok := x.Record.(*ChangeStreamRecord_CloseStream); ok {
+			return x.CloseStream
+		}
+	}
+	return nil
+}
+
+type isChangeStreamRecord_Record interface {
+	isChangeStreamRecord_Record()
+}
+
+type ChangeStreamRecord_Mutation struct {
+	// A data change.
+	Mutation *ChangeStreamRecord_ChangeStreamMutation `protobuf:"bytes,1,opt,name=mutation,proto3,oneof"`
+}
+
+type ChangeStreamRecord_Heartbeat struct {
+	// A heartbeat with the continuation token of the partition.
+	Heartbeat *bigtablepb.ReadChangeStreamResponse_Heartbeat `protobuf:"bytes,2,opt,name=heartbeat,proto3,oneof"`
+}
+
+type ChangeStreamRecord_CloseStream struct {
+	// The end of the stream, with the partitions to continue from if any.
+	CloseStream *bigtablepb.ReadChangeStreamResponse_CloseStream `protobuf:"bytes,3,opt,name=close_stream,json=closeStream,proto3,oneof"`
+}
+
+func (*ChangeStreamRecord_Mutation) isChangeStreamRecord_Record() {}
+
+func (*ChangeStreamRecord_Heartbeat) isChangeStreamRecord_Record() {}
+
+func (*ChangeStreamRecord_CloseStream) isChangeStreamRecord_Record() {}
+
+// Response from test proxy service for ReadChangeStreamRequest.
+type ReadChangeStreamResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The RPC status from the client binding.
+	Status *status.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// The change stream records in the order they are received.
+	Records       []*ChangeStreamRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadChangeStreamResult) Reset() {
+	*x = ReadChangeStreamResult{}
+	mi := &file_test_proxy_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadChangeStreamResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadChangeStreamResult) ProtoMessage() {}
+
+func (x *ReadChangeStreamResult) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadChangeStreamResult.ProtoReflect.Descriptor instead.
+func (*ReadChangeStreamResult) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReadChangeStreamResult) GetStatus() *status.Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ReadChangeStreamResult) GetRecords() []*ChangeStreamRecord {
+	if x != nil {
+		return x.Records
 	}
 	return nil
 }
@@ -1382,13 +2807,17 @@ type CreateClientRequest_SecurityOptions struct {
 	// root certs will be used instead. The default can be overridden via the
 	// GRPC_DEFAULT_SSL_ROOTS_FILE_PATH env var.
 	SslRootCertsPem string `protobuf:"bytes,4,opt,name=ssl_root_certs_pem,json=sslRootCertsPem,proto3" json:"ssl_root_certs_pem,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Whether to send the access token over a plaintext connection when
+	// `use_ssl` is not set. By default, the client must never send it without
+	// SSL.
+	AllowInsecureAccessToken bool `protobuf:"varint,5,opt,name=allow_insecure_access_token,json=allowInsecureAccessToken,proto3" json:"allow_insecure_access_token,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
 }
 
 func (x *CreateClientRequest_SecurityOptions) Reset() {
 	*x = CreateClientRequest_SecurityOptions{}
-	mi := &file_test_proxy_proto_msgTypes[23]
+	mi := &file_test_proxy_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1400,7 +2829,7 @@ func (x *CreateClientRequest_SecurityOptions) String() string {
 func (*CreateClientRequest_SecurityOptions) ProtoMessage() {}
 
 func (x *CreateClientRequest_SecurityOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_test_proxy_proto_msgTypes[23]
+	mi := &file_test_proxy_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1444,6 +2873,123 @@ func (x *CreateClientRequest_SecurityOptions) GetSslRootCertsPem() string {
 	return ""
 }
 
+func (x *CreateClientRequest_SecurityOptions) GetAllowInsecureAccessToken() bool {
+	if x != nil {
+		return x.AllowInsecureAccessToken
+	}
+	return false
+}
+
+// A logical mutation of a row, assembled from the chunks of one or more
+// DataChange messages.
+type ChangeStreamRecord_ChangeStreamMutation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The row key of the mutated row.
+	RowKey []byte `protobuf:"bytes,1,opt,name=row_key,json=rowKey,proto3" json:"row_key,omitempty"`
+	// The type of the mutation.
+	Type bigtablepb.ReadChangeStreamResponse_DataChange_Type `protobuf:"varint,2,opt,name=type,proto3,enum=google.bigtable.v2.ReadChangeStreamResponse_DataChange_Type" json:"type,omitempty"`
+	// The cluster where the mutation was applied.
+	SourceClusterId string `protobuf:"bytes,3,opt,name=source_cluster_id,json=sourceClusterId,proto3" json:"source_cluster_id,omitempty"`
+	// The timestamp at which the mutation was applied.
+	CommitTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=commit_timestamp,json=commitTimestamp,proto3" json:"commit_timestamp,omitempty"`
+	// The tiebreaker of the mutation.
+	Tiebreaker int32 `protobuf:"varint,5,opt,name=tiebreaker,proto3" json:"tiebreaker,omitempty"`
+	// The mutations, where the chunked values have been merged.
+	Mutations []*bigtablepb.Mutation `protobuf:"bytes,6,rep,name=mutations,proto3" json:"mutations,omitempty"`
+	// The continuation token of the last DataChange of the mutation.
+	Token string `protobuf:"bytes,7,opt,name=token,proto3" json:"token,omitempty"`
+	// The estimated low watermark of the last DataChange of the mutation.
+	EstimatedLowWatermark *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=estimated_low_watermark,json=estimatedLowWatermark,proto3" json:"estimated_low_watermark,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) Reset() {
+	*x = ChangeStreamRecord_ChangeStreamMutation{}
+	mi := &file_test_proxy_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeStreamRecord_ChangeStreamMutation) ProtoMessage() {}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_test_proxy_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeStreamRecord_ChangeStreamMutation.ProtoReflect.Descriptor instead.
+func (*ChangeStreamRecord_ChangeStreamMutation) Descriptor() ([]byte, []int) {
+	return file_test_proxy_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) GetRowKey() []byte {
+	if x != nil {
+		return x.RowKey
+	}
+	return nil
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) GetType() bigtablepb.ReadChangeStreamResponse_DataChange_Type {
+	if x != nil {
+		return x.Type
+	}
+	return bigtablepb.ReadChangeStreamResponse_DataChange_Type(0)
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) GetSourceClusterId() string {
+	if x != nil {
+		return x.SourceClusterId
+	}
+	return ""
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) GetCommitTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CommitTimestamp
+	}
+	return nil
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) GetTiebreaker() int32 {
+	if x != nil {
+		return x.Tiebreaker
+	}
+	return 0
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) GetMutations() []*bigtablepb.Mutation {
+	if x != nil {
+		return x.Mutations
+	}
+	return nil
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ChangeStreamRecord_ChangeStreamMutation) GetEstimatedLowWatermark() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EstimatedLowWatermark
+	}
+	return nil
+}
+
 var File_test_proxy_proto protoreflect.FileDescriptor
 
 var file_test_proxy_proto_rawDesc = []byte{
@@ -1451,291 +2997,625 @@ var file_test_proxy_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x12, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61,
 	0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x1a, 0x17, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x21, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x62,
-	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x62, 0x69, 0x67, 0x74, 0x61,
-	0x62, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x61,
-	0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x8e, 0x05, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
-	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74,
-	0x61, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
-	0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x5f, 0x70,
-	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x61, 0x70, 0x70, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x4d, 0x0a,
-	0x15, 0x70, 0x65, 0x72, 0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x70, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x68, 0x0a, 0x17,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e,
-	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x61, 0x6c, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x15, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x69, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69,
-	0x74, 0x79, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x3e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x2e, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x0f, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x1a, 0xae, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63,
-	0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x5f,
-	0x73, 0x73, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73, 0x65, 0x53, 0x73,
-	0x6c, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x73, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x13, 0x73, 0x73, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x76, 0x65,
-	0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x73, 0x73, 0x6c, 0x5f, 0x72, 0x6f, 0x6f,
-	0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x73, 0x73, 0x6c, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72, 0x74, 0x73, 0x50,
-	0x65, 0x6d, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x12, 0x43, 0x6c,
-	0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x15, 0x0a,
-	0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x62,
+	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2f, 0x76, 0x32,
+	0x2f, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f,
+	0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2f, 0x61, 0x64, 0x6d,
+	0x69, 0x6e, 0x2f, 0x76, 0x32, 0x2f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x21, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x62, 0x69, 0x67,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x2f, 0x76, 0x32, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x72, 0x70, 0x63,
+	0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4, 0x05,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x61, 0x74, 0x61, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x69,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63,
+	0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x70, 0x70,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x4d, 0x0a, 0x15, 0x70, 0x65, 0x72,
+	0x5f, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x13, 0x70, 0x65, 0x72, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x68, 0x0a, 0x17, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x15, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x69, 0x0a, 0x10, 0x73, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x63,
+	0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0f, 0x73, 0x65,
+	0x63, 0x75, 0x72, 0x69, 0x74, 0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a,
+	0x0e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x1a, 0xed, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x63, 0x75, 0x72, 0x69, 0x74,
+	0x79, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x5f, 0x73, 0x73, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x53, 0x73, 0x6c, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x73, 0x6c, 0x5f, 0x65, 0x6e, 0x64, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x73, 0x6c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x2b, 0x0a, 0x12, 0x73, 0x73, 0x6c, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x6d, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x73, 0x6c, 0x52, 0x6f, 0x6f, 0x74, 0x43, 0x65, 0x72,
+	0x74, 0x73, 0x50, 0x65, 0x6d, 0x12, 0x3d, 0x0a, 0x1b, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x69,
+	0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x0a, 0x12,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22,
+	0x15, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e,
+	0x52, 0x6f, 0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x22, 0x62, 0x0a, 0x09, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x03,
+	0x72, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x6f, 0x77, 0x52, 0x03, 0x72, 0x6f, 0x77, 0x22, 0xbb, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x9c, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f,
-	0x77, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22,
-	0x62, 0x0a, 0x09, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62,
-	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x03,
-	0x72, 0x6f, 0x77, 0x22, 0x99, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62,
-	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0f, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52,
+	0x6f, 0x77, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x65, 0x0a, 0x0a, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x91, 0x01, 0x0a,
+	0x10, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e,
+	0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x22, 0x3d, 0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x93, 0x01, 0x0a, 0x11, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52,
 	0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x61, 0x66,
-	0x74, 0x65, 0x72, 0x5f, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
-	0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x6f, 0x77, 0x73, 0x22,
-	0x65, 0x0a, 0x0a, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x04, 0x72, 0x6f, 0x77,
-	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x6f, 0x77,
-	0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x6f, 0x0a, 0x10, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3d, 0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74,
+	0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74,
 	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x71, 0x0a, 0x11, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x93,
+	0x02, 0x0a, 0x0f, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
+	0x61, 0x78, 0x42, 0x61, 0x74, 0x63, 0x68, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x26,
+	0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0e, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x66, 0x6c, 0x75, 0x73, 0x68,
+	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x36, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f,
+	0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x4f, 0x75,
+	0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x32, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x6d, 0x61, 0x78, 0x4f, 0x75, 0x74, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0xeb, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x65, 0x64, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x56, 0x69, 0x65, 0x77, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9d, 0x01, 0x0a, 0x18,
+	0x41, 0x64, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62,
+	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x45, 0x0a, 0x17, 0x41,
+	0x64, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x51, 0x0a, 0x13, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x61,
+	0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x42, 0x61, 0x74,
+	0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0xa1,
+	0x01, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d,
-	0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x10, 0x4d, 0x75,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d,
+	0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a,
 	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x65, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32,
-	0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x22, 0x7f, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
-	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x07, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76,
-	0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64,
-	0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
-	0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x72,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32,
-	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x77, 0x0a, 0x14, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x45, 0x0a, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x99, 0x01, 0x0a, 0x14, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b,
 	0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c,
 	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
 	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
 	0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x61,
 	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x13,
-	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x43, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b,
-	0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x73, 0x61, 0x6d,
-	0x70, 0x6c, 0x65, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x6f, 0x64,
-	0x69, 0x66, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
-	0x47, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
-	0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
-	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x75, 0x0a, 0x13, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x41, 0x0a, 0x07,
-	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e,
-	0x76, 0x32, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52,
+	0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x86, 0x01,
+	0x0a, 0x13, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72,
+	0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x43, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f,
+	0x77, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x07, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x61, 0x64, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x69,
+	0x66, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72,
+	0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0x97, 0x01, 0x0a,
+	0x13, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x41, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xc1, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x53, 0x71,
+	0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x22, 0x51, 0x0a, 0x11, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x63, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x3b, 0x0a,
+	0x06, 0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x12, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a,
+	0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x70, 0x0a, 0x0b, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x35, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x79, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x07, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x64,
+	0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x3f, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x8b, 0x01, 0x0a, 0x1b, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x43, 0x6f,
+	0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x4f, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x35, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x7b, 0x0a, 0x13, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x6f, 0x77, 0x52, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x6f, 0x77, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x40,
+	0x0a, 0x12, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x6f, 0x77, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70,
+	0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x93, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x53, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x39, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa0, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x52, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62,
+	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x32,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x17, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x4b, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76, 0x32, 0x2e, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0xc1, 0x01, 0x0a, 0x12, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x48, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69,
-	0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x35, 0x0a, 0x04,
-	0x72, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72,
-	0x6f, 0x77, 0x73, 0x22, 0x51, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x74,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x6f, 0x6c, 0x75,
-	0x6d, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x43,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x07, 0x63,
-	0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x73, 0x22, 0x3b, 0x0a, 0x06, 0x53, 0x71, 0x6c, 0x52, 0x6f, 0x77,
-	0x12, 0x31, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
-	0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x2a, 0x64, 0x0a, 0x15, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46,
-	0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x1f,
-	0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45,
-	0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10,
-	0x00, 0x12, 0x26, 0x0a, 0x22, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x45,
-	0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x4e, 0x41,
-	0x42, 0x4c, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x01, 0x32, 0x95, 0x0a, 0x0a, 0x18, 0x43, 0x6c,
-	0x6f, 0x75, 0x64, 0x42, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x32, 0x54, 0x65, 0x73,
-	0x74, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x71, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0b, 0x43, 0x6c, 0x6f,
-	0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0c, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74,
-	0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x07,
-	0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52,
-	0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x08, 0x52, 0x65,
-	0x61, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52,
-	0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x09, 0x4d,
-	0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70,
-	0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62,
-	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x0e, 0x42, 0x75, 0x6c, 0x6b, 0x4d, 0x75, 0x74, 0x61, 0x74,
-	0x65, 0x52, 0x6f, 0x77, 0x73, 0x12, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62,
+	0x90, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4a, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x2e, 0x76,
+	0x32, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0xd1, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x07, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76,
+	0x32, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x5f, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x5f, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x12, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x41, 0x66, 0x74, 0x65, 0x72, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x22, 0xf4, 0x05, 0x0a, 0x12, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x60, 0x0a,
+	0x08, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x42, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x2e, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x56, 0x0a, 0x09, 0x68, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x36, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x48, 0x00, 0x52, 0x09, 0x68, 0x65,
+	0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x12, 0x5d, 0x0a, 0x0c, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e,
+	0x76, 0x32, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x00, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x1a, 0xba, 0x03, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x17, 0x0a, 0x07, 0x72, 0x6f, 0x77, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x72, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x12, 0x50, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x3c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x52, 0x65, 0x61, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x63, 0x6f,
+	0x6d, 0x6d, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x69, 0x65, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0a, 0x74, 0x69, 0x65, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x3a, 0x0a,
+	0x09, 0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09,
+	0x6d, 0x75, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x52, 0x0a, 0x17, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x77,
+	0x5f, 0x77, 0x61, 0x74, 0x65, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x15, 0x65, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x4c, 0x6f, 0x77, 0x57, 0x61, 0x74, 0x65, 0x72, 0x6d,
+	0x61, 0x72, 0x6b, 0x42, 0x08, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x22, 0x8d, 0x01,
+	0x0a, 0x16, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62,
 	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78,
-	0x79, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67,
+	0x79, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x52, 0x07, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x73, 0x2a, 0x64, 0x0a,
+	0x15, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e,
+	0x41, 0x4c, 0x5f, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49,
+	0x47, 0x5f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c, 0x54, 0x10, 0x00, 0x12, 0x26, 0x0a, 0x22, 0x4f,
+	0x50, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x46, 0x45, 0x41, 0x54, 0x55, 0x52, 0x45, 0x5f,
+	0x43, 0x4f, 0x4e, 0x46, 0x49, 0x47, 0x5f, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0x01, 0x32, 0xb4, 0x14, 0x0a, 0x18, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x42, 0x69, 0x67,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x56, 0x32, 0x54, 0x65, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x12, 0x71, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x6e, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43,
+	0x6c, 0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x71, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67,
 	0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
-	0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x00, 0x12, 0x7e, 0x0a, 0x11, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75,
-	0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x12, 0x33, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x07, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f,
+	0x77, 0x12, 0x29, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61,
+	0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x08, 0x52, 0x65, 0x61, 0x64, 0x52, 0x6f, 0x77, 0x73,
+	0x12, 0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65, 0x61,
+	0x64, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x66, 0x0a, 0x09, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x77, 0x12, 0x2b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4d,
+	0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x6d, 0x0a,
+	0x0e, 0x42, 0x75, 0x6c, 0x6b, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x12,
+	0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4d, 0x75, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x77, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x74, 0x0a, 0x0d,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x2f, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x7e, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x67,
+	0x6f, 0x78, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e,
-	0x64, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x22, 0x00, 0x12, 0x72, 0x0a, 0x0d, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b,
-	0x65, 0x79, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67,
-	0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e,
-	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x41, 0x64, 0x64, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0c, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x72, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x46,
+	0x6c, 0x75, 0x73, 0x68, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x6a,
+	0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x12, 0x2e,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x11, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x12,
+	0x33, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69,
 	0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
-	0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x6f,
-	0x64, 0x69, 0x66, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x12, 0x34, 0x2e, 0x67,
+	0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x41, 0x6e, 0x64, 0x4d, 0x75, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x72, 0x0a, 0x0d, 0x53, 0x61,
+	0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x2f, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x6f,
+	0x77, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x6f, 0x64,
-	0x69, 0x66, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
-	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52,
-	0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0c, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f,
+	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52,
+	0x6f, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x72,
+	0x0a, 0x12, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x57, 0x72, 0x69, 0x74,
+	0x65, 0x52, 0x6f, 0x77, 0x12, 0x34, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69,
+	0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79,
+	0x2e, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x57, 0x72, 0x69, 0x74, 0x65,
+	0x52, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x6f,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x22, 0x00, 0x12, 0x6f, 0x0a, 0x0c, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x32, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x12, 0x66, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x78, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x36,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66,
+	0x79, 0x43, 0x6f, 0x6c, 0x75, 0x6d, 0x6e, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x2e, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00,
+	0x12, 0x6f, 0x0a, 0x0c, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x6f, 0x77, 0x52, 0x61, 0x6e, 0x67, 0x65,
+	0x12, 0x2e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x44, 0x72, 0x6f,
+	0x70, 0x52, 0x6f, 0x77, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62,
+	0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x44, 0x72, 0x6f,
+	0x70, 0x52, 0x6f, 0x77, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22,
+	0x00, 0x12, 0x93, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43, 0x6f,
+	0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73,
-	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x1a, 0x34, 0xca, 0x41, 0x31,
-	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x70, 0x72,
-	0x6f, 0x78, 0x79, 0x2d, 0x6e, 0x6f, 0x74, 0x2d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62,
-	0x6c, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f,
-	0x6d, 0x42, 0x67, 0x0a, 0x23, 0x63, 0x6f, 0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74,
-	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x50, 0x01, 0x5a, 0x3e, 0x63, 0x6c, 0x6f, 0x75,
-	0x64, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2f,
-	0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f,
-	0x78, 0x79, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x70, 0x62, 0x3b, 0x74,
-	0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x00, 0x12, 0x7b, 0x0a, 0x10, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x32, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
+	0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x31, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2e, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x00, 0x1a, 0x34, 0xca, 0x41, 0x31, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2d, 0x74, 0x65, 0x73, 0x74, 0x2d, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2d, 0x6e, 0x6f, 0x74,
+	0x2d, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x61, 0x70, 0x69, 0x73, 0x2e, 0x63, 0x6f, 0x6d, 0x42, 0x67, 0x0a, 0x23, 0x63, 0x6f,
+	0x6d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x62,
+	0x69, 0x67, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x50, 0x01, 0x5a, 0x3e, 0x63, 0x6c, 0x6f, 0x75, 0x64, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2f, 0x62, 0x69, 0x67, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x70, 0x62, 0x3b, 0x74, 0x65, 0x73, 0x74, 0x70, 0x72, 0x6f, 0x78,
+	0x79, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1751,105 +3631,196 @@ func file_test_proxy_proto_rawDescGZIP() []byte {
 }
 
 var file_test_proxy_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_test_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
+var file_test_proxy_proto_msgTypes = make([]protoimpl.MessageInfo, 47)
 var file_test_proxy_proto_goTypes = []any{
-	(OptionalFeatureConfig)(0),                   // 0: google.bigtable.testproxy.OptionalFeatureConfig
-	(*CreateClientRequest)(nil),                  // 1: google.bigtable.testproxy.CreateClientRequest
-	(*CreateClientResponse)(nil),                 // 2: google.bigtable.testproxy.CreateClientResponse
-	(*CloseClientRequest)(nil),                   // 3: google.bigtable.testproxy.CloseClientRequest
-	(*CloseClientResponse)(nil),                  // 4: google.bigtable.testproxy.CloseClientResponse
-	(*RemoveClientRequest)(nil),                  // 5: google.bigtable.testproxy.RemoveClientRequest
-	(*RemoveClientResponse)(nil),                 // 6: google.bigtable.testproxy.RemoveClientResponse
-	(*ReadRowRequest)(nil),                       // 7: google.bigtable.testproxy.ReadRowRequest
-	(*RowResult)(nil),                            // 8: google.bigtable.testproxy.RowResult
-	(*ReadRowsRequest)(nil),                      // 9: google.bigtable.testproxy.ReadRowsRequest
-	(*RowsResult)(nil),                           // 10: google.bigtable.testproxy.RowsResult
-	(*MutateRowRequest)(nil),                     // 11: google.bigtable.testproxy.MutateRowRequest
-	(*MutateRowResult)(nil),                      // 12: google.bigtable.testproxy.MutateRowResult
-	(*MutateRowsRequest)(nil),                    // 13: google.bigtable.testproxy.MutateRowsRequest
-	(*MutateRowsResult)(nil),                     // 14: google.bigtable.testproxy.MutateRowsResult
-	(*CheckAndMutateRowRequest)(nil),             // 15: google.bigtable.testproxy.CheckAndMutateRowRequest
-	(*CheckAndMutateRowResult)(nil),              // 16: google.bigtable.testproxy.CheckAndMutateRowResult
-	(*SampleRowKeysRequest)(nil),                 // 17: google.bigtable.testproxy.SampleRowKeysRequest
-	(*SampleRowKeysResult)(nil),                  // 18: google.bigtable.testproxy.SampleRowKeysResult
-	(*ReadModifyWriteRowRequest)(nil),            // 19: google.bigtable.testproxy.ReadModifyWriteRowRequest
-	(*ExecuteQueryRequest)(nil),                  // 20: google.bigtable.testproxy.ExecuteQueryRequest
-	(*ExecuteQueryResult)(nil),                   // 21: google.bigtable.testproxy.ExecuteQueryResult
-	(*ResultSetMetadata)(nil),                    // 22: google.bigtable.testproxy.ResultSetMetadata
-	(*SqlRow)(nil),                               // 23: google.bigtable.testproxy.SqlRow
-	(*CreateClientRequest_SecurityOptions)(nil),  // 24: google.bigtable.testproxy.CreateClientRequest.SecurityOptions
-	(*durationpb.Duration)(nil),                  // 25: google.protobuf.Duration
-	(*bigtablepb.RowFilter)(nil),                 // 26: google.bigtable.v2.RowFilter
-	(*status.Status)(nil),                        // 27: google.rpc.Status
-	(*bigtablepb.Row)(nil),                       // 28: google.bigtable.v2.Row
-	(*bigtablepb.ReadRowsRequest)(nil),           // 29: google.bigtable.v2.ReadRowsRequest
-	(*bigtablepb.MutateRowRequest)(nil),          // 30: google.bigtable.v2.MutateRowRequest
-	(*bigtablepb.MutateRowsRequest)(nil),         // 31: google.bigtable.v2.MutateRowsRequest
-	(*bigtablepb.MutateRowsResponse_Entry)(nil),  // 32: google.bigtable.v2.MutateRowsResponse.Entry
-	(*bigtablepb.CheckAndMutateRowRequest)(nil),  // 33: google.bigtable.v2.CheckAndMutateRowRequest
-	(*bigtablepb.CheckAndMutateRowResponse)(nil), // 34: google.bigtable.v2.CheckAndMutateRowResponse
-	(*bigtablepb.SampleRowKeysRequest)(nil),      // 35: google.bigtable.v2.SampleRowKeysRequest
-	(*bigtablepb.SampleRowKeysResponse)(nil),     // 36: google.bigtable.v2.SampleRowKeysResponse
-	(*bigtablepb.ReadModifyWriteRowRequest)(nil), // 37: google.bigtable.v2.ReadModifyWriteRowRequest
-	(*bigtablepb.ExecuteQueryRequest)(nil),       // 38: google.bigtable.v2.ExecuteQueryRequest
-	(*bigtablepb.ColumnMetadata)(nil),            // 39: google.bigtable.v2.ColumnMetadata
-	(*bigtablepb.Value)(nil),                     // 40: google.bigtable.v2.Value
+	(OptionalFeatureConfig)(0),                               // 0: google.bigtable.testproxy.OptionalFeatureConfig
+	(*CreateClientRequest)(nil),                              // 1: google.bigtable.testproxy.CreateClientRequest
+	(*CreateClientResponse)(nil),                             // 2: google.bigtable.testproxy.CreateClientResponse
+	(*CloseClientRequest)(nil),                               // 3: google.bigtable.testproxy.CloseClientRequest
+	(*CloseClientResponse)(nil),                              // 4: google.bigtable.testproxy.CloseClientResponse
+	(*RemoveClientRequest)(nil),                              // 5: google.bigtable.testproxy.RemoveClientRequest
+	(*RemoveClientResponse)(nil),                             // 6: google.bigtable.testproxy.RemoveClientResponse
+	(*ReadRowRequest)(nil),                                   // 7: google.bigtable.testproxy.ReadRowRequest
+	(*RowResult)(nil),                                        // 8: google.bigtable.testproxy.RowResult
+	(*ReadRowsRequest)(nil),                                  // 9: google.bigtable.testproxy.ReadRowsRequest
+	(*RowsResult)(nil),                                       // 10: google.bigtable.testproxy.RowsResult
+	(*MutateRowRequest)(nil),                                 // 11: google.bigtable.testproxy.MutateRowRequest
+	(*MutateRowResult)(nil),                                  // 12: google.bigtable.testproxy.MutateRowResult
+	(*MutateRowsRequest)(nil),                                // 13: google.bigtable.testproxy.MutateRowsRequest
+	(*MutateRowsResult)(nil),                                 // 14: google.bigtable.testproxy.MutateRowsResult
+	(*BatcherSettings)(nil),                                  // 15: google.bigtable.testproxy.BatcherSettings
+	(*CreateBatcherRequest)(nil),                             // 16: google.bigtable.testproxy.CreateBatcherRequest
+	(*CreateBatcherResponse)(nil),                            // 17: google.bigtable.testproxy.CreateBatcherResponse
+	(*AddBatcherEntriesRequest)(nil),                         // 18: google.bigtable.testproxy.AddBatcherEntriesRequest
+	(*AddBatcherEntriesResult)(nil),                          // 19: google.bigtable.testproxy.AddBatcherEntriesResult
+	(*FlushBatcherRequest)(nil),                              // 20: google.bigtable.testproxy.FlushBatcherRequest
+	(*CloseBatcherRequest)(nil),                              // 21: google.bigtable.testproxy.CloseBatcherRequest
+	(*BatcherResult)(nil),                                    // 22: google.bigtable.testproxy.BatcherResult
+	(*CheckAndMutateRowRequest)(nil),                         // 23: google.bigtable.testproxy.CheckAndMutateRowRequest
+	(*CheckAndMutateRowResult)(nil),                          // 24: google.bigtable.testproxy.CheckAndMutateRowResult
+	(*SampleRowKeysRequest)(nil),                             // 25: google.bigtable.testproxy.SampleRowKeysRequest
+	(*SampleRowKeysResult)(nil),                              // 26: google.bigtable.testproxy.SampleRowKeysResult
+	(*ReadModifyWriteRowRequest)(nil),                        // 27: google.bigtable.testproxy.ReadModifyWriteRowRequest
+	(*ExecuteQueryRequest)(nil),                              // 28: google.bigtable.testproxy.ExecuteQueryRequest
+	(*ExecuteQueryResult)(nil),                               // 29: google.bigtable.testproxy.ExecuteQueryResult
+	(*ResultSetMetadata)(nil),                                // 30: google.bigtable.testproxy.ResultSetMetadata
+	(*SqlRow)(nil),                                           // 31: google.bigtable.testproxy.SqlRow
+	(*CreateTableRequest)(nil),                               // 32: google.bigtable.testproxy.CreateTableRequest
+	(*TableResult)(nil),                                      // 33: google.bigtable.testproxy.TableResult
+	(*DeleteTableRequest)(nil),                               // 34: google.bigtable.testproxy.DeleteTableRequest
+	(*DeleteTableResult)(nil),                                // 35: google.bigtable.testproxy.DeleteTableResult
+	(*ModifyColumnFamiliesRequest)(nil),                      // 36: google.bigtable.testproxy.ModifyColumnFamiliesRequest
+	(*DropRowRangeRequest)(nil),                              // 37: google.bigtable.testproxy.DropRowRangeRequest
+	(*DropRowRangeResult)(nil),                               // 38: google.bigtable.testproxy.DropRowRangeResult
+	(*GenerateConsistencyTokenRequest)(nil),                  // 39: google.bigtable.testproxy.GenerateConsistencyTokenRequest
+	(*GenerateConsistencyTokenResult)(nil),                   // 40: google.bigtable.testproxy.GenerateConsistencyTokenResult
+	(*CheckConsistencyRequest)(nil),                          // 41: google.bigtable.testproxy.CheckConsistencyRequest
+	(*CheckConsistencyResult)(nil),                           // 42: google.bigtable.testproxy.CheckConsistencyResult
+	(*ReadChangeStreamRequest)(nil),                          // 43: google.bigtable.testproxy.ReadChangeStreamRequest
+	(*ChangeStreamRecord)(nil),                               // 44: google.bigtable.testproxy.ChangeStreamRecord
+	(*ReadChangeStreamResult)(nil),                           // 45: google.bigtable.testproxy.ReadChangeStreamResult
+	(*CreateClientRequest_SecurityOptions)(nil),              // 46: google.bigtable.testproxy.CreateClientRequest.SecurityOptions
+	(*ChangeStreamRecord_ChangeStreamMutation)(nil),          // 47: google.bigtable.testproxy.ChangeStreamRecord.ChangeStreamMutation
+	(*durationpb.Duration)(nil),                              // 48: google.protobuf.Duration
+	(*bigtablepb.RowFilter)(nil),                             // 49: google.bigtable.v2.RowFilter
+	(*status.Status)(nil),                                    // 50: google.rpc.Status
+	(*bigtablepb.Row)(nil),                                   // 51: google.bigtable.v2.Row
+	(*bigtablepb.ReadRowsRequest)(nil),                       // 52: google.bigtable.v2.ReadRowsRequest
+	(*bigtablepb.MutateRowRequest)(nil),                      // 53: google.bigtable.v2.MutateRowRequest
+	(*bigtablepb.MutateRowsRequest)(nil),                     // 54: google.bigtable.v2.MutateRowsRequest
+	(*bigtablepb.MutateRowsResponse_Entry)(nil),              // 55: google.bigtable.v2.MutateRowsResponse.Entry
+	(*bigtablepb.MutateRowsRequest_Entry)(nil),               // 56: google.bigtable.v2.MutateRowsRequest.Entry
+	(*bigtablepb.CheckAndMutateRowRequest)(nil),              // 57: google.bigtable.v2.CheckAndMutateRowRequest
+	(*bigtablepb.CheckAndMutateRowResponse)(nil),             // 58: google.bigtable.v2.CheckAndMutateRowResponse
+	(*bigtablepb.SampleRowKeysRequest)(nil),                  // 59: google.bigtable.v2.SampleRowKeysRequest
+	(*bigtablepb.SampleRowKeysResponse)(nil),                 // 60: google.bigtable.v2.SampleRowKeysResponse
+	(*bigtablepb.ReadModifyWriteRowRequest)(nil),             // 61: google.bigtable.v2.ReadModifyWriteRowRequest
+	(*bigtablepb.ExecuteQueryRequest)(nil),                   // 62: google.bigtable.v2.ExecuteQueryRequest
+	(*bigtablepb.ColumnMetadata)(nil),                        // 63: google.bigtable.v2.ColumnMetadata
+	(*bigtablepb.Value)(nil),                                 // 64: google.bigtable.v2.Value
+	(*adminpb.CreateTableRequest)(nil),                       // 65: google.bigtable.admin.v2.CreateTableRequest
+	(*adminpb.Table)(nil),                                    // 66: google.bigtable.admin.v2.Table
+	(*adminpb.DeleteTableRequest)(nil),                       // 67: google.bigtable.admin.v2.DeleteTableRequest
+	(*adminpb.ModifyColumnFamiliesRequest)(nil),              // 68: google.bigtable.admin.v2.ModifyColumnFamiliesRequest
+	(*adminpb.DropRowRangeRequest)(nil),                      // 69: google.bigtable.admin.v2.DropRowRangeRequest
+	(*adminpb.GenerateConsistencyTokenRequest)(nil),          // 70: google.bigtable.admin.v2.GenerateConsistencyTokenRequest
+	(*adminpb.GenerateConsistencyTokenResponse)(nil),         // 71: google.bigtable.admin.v2.GenerateConsistencyTokenResponse
+	(*adminpb.CheckConsistencyRequest)(nil),                  // 72: google.bigtable.admin.v2.CheckConsistencyRequest
+	(*adminpb.CheckConsistencyResponse)(nil),                 // 73: google.bigtable.admin.v2.CheckConsistencyResponse
+	(*bigtablepb.ReadChangeStreamRequest)(nil),               // 74: google.bigtable.v2.ReadChangeStreamRequest
+	(*bigtablepb.ReadChangeStreamResponse_Heartbeat)(nil),    // 75: google.bigtable.v2.ReadChangeStreamResponse.Heartbeat
+	(*bigtablepb.ReadChangeStreamResponse_CloseStream)(nil),  // 76: google.bigtable.v2.ReadChangeStreamResponse.CloseStream
+	(bigtablepb.ReadChangeStreamResponse_DataChange_Type)(0), // 77: google.bigtable.v2.ReadChangeStreamResponse.DataChange.Type
+	(*timestamppb.Timestamp)(nil),                            // 78: google.protobuf.Timestamp
+	(*bigtablepb.Mutation)(nil),                              // 79: google.bigtable.v2.Mutation
 }
 var file_test_proxy_proto_depIdxs = []int32{
-	25, // 0: google.bigtable.testproxy.CreateClientRequest.per_operation_timeout:type_name -> google.protobuf.Duration
+	48, // 0: google.bigtable.testproxy.CreateClientRequest.per_operation_timeout:type_name -> google.protobuf.Duration
 	0,  // 1: google.bigtable.testproxy.CreateClientRequest.optional_feature_config:type_name -> google.bigtable.testproxy.OptionalFeatureConfig
-	24, // 2: google.bigtable.testproxy.CreateClientRequest.security_options:type_name -> google.bigtable.testproxy.CreateClientRequest.SecurityOptions
-	26, // 3: google.bigtable.testproxy.ReadRowRequest.filter:type_name -> google.bigtable.v2.RowFilter
-	27, // 4: google.bigtable.testproxy.RowResult.status:type_name -> google.rpc.Status
-	28, // 5: google.bigtable.testproxy.RowResult.row:type_name -> google.bigtable.v2.Row
-	29, // 6: google.bigtable.testproxy.ReadRowsRequest.request:type_name -> google.bigtable.v2.ReadRowsRequest
-	27, // 7: google.bigtable.testproxy.RowsResult.status:type_name -> google.rpc.Status
-	28, // 8: google.bigtable.testproxy.RowsResult.rows:type_name -> google.bigtable.v2.Row
-	30, // 9: google.bigtable.testproxy.MutateRowRequest.request:type_name -> google.bigtable.v2.MutateRowRequest
-	27, // 10: google.bigtable.testproxy.MutateRowResult.status:type_name -> google.rpc.Status
-	31, // 11: google.bigtable.testproxy.MutateRowsRequest.request:type_name -> google.bigtable.v2.MutateRowsRequest
-	27, // 12: google.bigtable.testproxy.MutateRowsResult.status:type_name -> google.rpc.Status
-	32, // 13: google.bigtable.testproxy.MutateRowsResult.entries:type_name -> google.bigtable.v2.MutateRowsResponse.Entry
-	33, // 14: google.bigtable.testproxy.CheckAndMutateRowRequest.request:type_name -> google.bigtable.v2.CheckAndMutateRowRequest
-	27, // 15: google.bigtable.testproxy.CheckAndMutateRowResult.status:type_name -> google.rpc.Status
-	34, // 16: google.bigtable.testproxy.CheckAndMutateRowResult.result:type_name -> google.bigtable.v2.CheckAndMutateRowResponse
-	35, // 17: google.bigtable.testproxy.SampleRowKeysRequest.request:type_name -> google.bigtable.v2.SampleRowKeysRequest
-	27, // 18: google.bigtable.testproxy.SampleRowKeysResult.status:type_name -> google.rpc.Status
-	36, // 19: google.bigtable.testproxy.SampleRowKeysResult.samples:type_name -> google.bigtable.v2.SampleRowKeysResponse
-	37, // 20: google.bigtable.testproxy.ReadModifyWriteRowRequest.request:type_name -> google.bigtable.v2.ReadModifyWriteRowRequest
-	38, // 21: google.bigtable.testproxy.ExecuteQueryRequest.request:type_name -> google.bigtable.v2.ExecuteQueryRequest
-	27, // 22: google.bigtable.testproxy.ExecuteQueryResult.status:type_name -> google.rpc.Status
-	22, // 23: google.bigtable.testproxy.ExecuteQueryResult.metadata:type_name -> google.bigtable.testproxy.ResultSetMetadata
-	23, // 24: google.bigtable.testproxy.ExecuteQueryResult.rows:type_name -> google.bigtable.testproxy.SqlRow
-	39, // 25: google.bigtable.testproxy.ResultSetMetadata.columns:type_name -> google.bigtable.v2.ColumnMetadata
-	40, // 26: google.bigtable.testproxy.SqlRow.values:type_name -> google.bigtable.v2.Value
-	1,  // 27: google.bigtable.testproxy.CloudBigtableV2TestProxy.CreateClient:input_type -> google.bigtable.testproxy.CreateClientRequest
-	3,  // 28: google.bigtable.testproxy.CloudBigtableV2TestProxy.CloseClient:input_type -> google.bigtable.testproxy.CloseClientRequest
-	5,  // 29: google.bigtable.testproxy.CloudBigtableV2TestProxy.RemoveClient:input_type -> google.bigtable.testproxy.RemoveClientRequest
-	7,  // 30: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadRow:input_type -> google.bigtable.testproxy.ReadRowRequest
-	9,  // 31: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadRows:input_type -> google.bigtable.testproxy.ReadRowsRequest
-	11, // 32: google.bigtable.testproxy.CloudBigtableV2TestProxy.MutateRow:input_type -> google.bigtable.testproxy.MutateRowRequest
-	13, // 33: google.bigtable.testproxy.CloudBigtableV2TestProxy.BulkMutateRows:input_type -> google.bigtable.testproxy.MutateRowsRequest
-	15, // 34: google.bigtable.testproxy.CloudBigtableV2TestProxy.CheckAndMutateRow:input_type -> google.bigtable.testproxy.CheckAndMutateRowRequest
-	17, // 35: google.bigtable.testproxy.CloudBigtableV2TestProxy.SampleRowKeys:input_type -> google.bigtable.testproxy.SampleRowKeysRequest
-	19, // 36: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadModifyWriteRow:input_type -> google.bigtable.testproxy.ReadModifyWriteRowRequest
-	20, // 37: google.bigtable.testproxy.CloudBigtableV2TestProxy.ExecuteQuery:input_type -> google.bigtable.testproxy.ExecuteQueryRequest
-	2,  // 38: google.bigtable.testproxy.CloudBigtableV2TestProxy.CreateClient:output_type -> google.bigtable.testproxy.CreateClientResponse
-	4,  // 39: google.bigtable.testproxy.CloudBigtableV2TestProxy.CloseClient:output_type -> google.bigtable.testproxy.CloseClientResponse
-	6,  // 40: google.bigtable.testproxy.CloudBigtableV2TestProxy.RemoveClient:output_type -> google.bigtable.testproxy.RemoveClientResponse
-	8,  // 41: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadRow:output_type -> google.bigtable.testproxy.RowResult
-	10, // 42: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadRows:output_type -> google.bigtable.testproxy.RowsResult
-	12, // 43: google.bigtable.testproxy.CloudBigtableV2TestProxy.MutateRow:output_type -> google.bigtable.testproxy.MutateRowResult
-	14, // 44: google.bigtable.testproxy.CloudBigtableV2TestProxy.BulkMutateRows:output_type -> google.bigtable.testproxy.MutateRowsResult
-	16, // 45: google.bigtable.testproxy.CloudBigtableV2TestProxy.CheckAndMutateRow:output_type -> google.bigtable.testproxy.CheckAndMutateRowResult
-	18, // 46: google.bigtable.testproxy.CloudBigtableV2TestProxy.SampleRowKeys:output_type -> google.bigtable.testproxy.SampleRowKeysResult
-	8,  // 47: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadModifyWriteRow:output_type -> google.bigtable.testproxy.RowResult
-	21, // 48: google.bigtable.testproxy.CloudBigtableV2TestProxy.ExecuteQuery:output_type -> google.bigtable.testproxy.ExecuteQueryResult
-	38, // [38:49] is the sub-list for method output_type
-	27, // [27:38] is the sub-list for method input_type
-	27, // [27:27] is the sub-list for extension type_name
-	27, // [27:27] is the sub-list for extension extendee
-	0,  // [0:27] is the sub-list for field type_name
+	46, // 2: google.bigtable.testproxy.CreateClientRequest.security_options:type_name -> google.bigtable.testproxy.CreateClientRequest.SecurityOptions
+	49, // 3: google.bigtable.testproxy.ReadRowRequest.filter:type_name -> google.bigtable.v2.RowFilter
+	50, // 4: google.bigtable.testproxy.RowResult.status:type_name -> google.rpc.Status
+	51, // 5: google.bigtable.testproxy.RowResult.row:type_name -> google.bigtable.v2.Row
+	52, // 6: google.bigtable.testproxy.ReadRowsRequest.request:type_name -> google.bigtable.v2.ReadRowsRequest
+	50, // 7: google.bigtable.testproxy.RowsResult.status:type_name -> google.rpc.Status
+	51, // 8: google.bigtable.testproxy.RowsResult.rows:type_name -> google.bigtable.v2.Row
+	53, // 9: google.bigtable.testproxy.MutateRowRequest.request:type_name -> google.bigtable.v2.MutateRowRequest
+	50, // 10: google.bigtable.testproxy.MutateRowResult.status:type_name -> google.rpc.Status
+	54, // 11: google.bigtable.testproxy.MutateRowsRequest.request:type_name -> google.bigtable.v2.MutateRowsRequest
+	50, // 12: google.bigtable.testproxy.MutateRowsResult.status:type_name -> google.rpc.Status
+	55, // 13: google.bigtable.testproxy.MutateRowsResult.entries:type_name -> google.bigtable.v2.MutateRowsResponse.Entry
+	48, // 14: google.bigtable.testproxy.BatcherSettings.flush_interval:type_name -> google.protobuf.Duration
+	15, // 15: google.bigtable.testproxy.CreateBatcherRequest.settings:type_name -> google.bigtable.testproxy.BatcherSettings
+	56, // 16: google.bigtable.testproxy.AddBatcherEntriesRequest.entries:type_name -> google.bigtable.v2.MutateRowsRequest.Entry
+	50, // 17: google.bigtable.testproxy.AddBatcherEntriesResult.status:type_name -> google.rpc.Status
+	50, // 18: google.bigtable.testproxy.BatcherResult.status:type_name -> google.rpc.Status
+	55, // 19: google.bigtable.testproxy.BatcherResult.entries:type_name -> google.bigtable.v2.MutateRowsResponse.Entry
+	57, // 20: google.bigtable.testproxy.CheckAndMutateRowRequest.request:type_name -> google.bigtable.v2.CheckAndMutateRowRequest
+	50, // 21: google.bigtable.testproxy.CheckAndMutateRowResult.status:type_name -> google.rpc.Status
+	58, // 22: google.bigtable.testproxy.CheckAndMutateRowResult.result:type_name -> google.bigtable.v2.CheckAndMutateRowResponse
+	59, // 23: google.bigtable.testproxy.SampleRowKeysRequest.request:type_name -> google.bigtable.v2.SampleRowKeysRequest
+	50, // 24: google.bigtable.testproxy.SampleRowKeysResult.status:type_name -> google.rpc.Status
+	60, // 25: google.bigtable.testproxy.SampleRowKeysResult.samples:type_name -> google.bigtable.v2.SampleRowKeysResponse
+	61, // 26: google.bigtable.testproxy.ReadModifyWriteRowRequest.request:type_name -> google.bigtable.v2.ReadModifyWriteRowRequest
+	62, // 27: google.bigtable.testproxy.ExecuteQueryRequest.request:type_name -> google.bigtable.v2.ExecuteQueryRequest
+	50, // 28: google.bigtable.testproxy.ExecuteQueryResult.status:type_name -> google.rpc.Status
+	30, // 29: google.bigtable.testproxy.ExecuteQueryResult.metadata:type_name -> google.bigtable.testproxy.ResultSetMetadata
+	31, // 30: google.bigtable.testproxy.ExecuteQueryResult.rows:type_name -> google.bigtable.testproxy.SqlRow
+	63, // 31: google.bigtable.testproxy.ResultSetMetadata.columns:type_name -> google.bigtable.v2.ColumnMetadata
+	64, // 32: google.bigtable.testproxy.SqlRow.values:type_name -> google.bigtable.v2.Value
+	65, // 33: google.bigtable.testproxy.CreateTableRequest.request:type_name -> google.bigtable.admin.v2.CreateTableRequest
+	50, // 34: google.bigtable.testproxy.TableResult.status:type_name -> google.rpc.Status
+	66, // 35: google.bigtable.testproxy.TableResult.table:type_name -> google.bigtable.admin.v2.Table
+	67, // 36: google.bigtable.testproxy.DeleteTableRequest.request:type_name -> google.bigtable.admin.v2.DeleteTableRequest
+	50, // 37: google.bigtable.testproxy.DeleteTableResult.status:type_name -> google.rpc.Status
+	68, // 38: google.bigtable.testproxy.ModifyColumnFamiliesRequest.request:type_name -> google.bigtable.admin.v2.ModifyColumnFamiliesRequest
+	69, // 39: google.bigtable.testproxy.DropRowRangeRequest.request:type_name -> google.bigtable.admin.v2.DropRowRangeRequest
+	50, // 40: google.bigtable.testproxy.DropRowRangeResult.status:type_name -> google.rpc.Status
+	70, // 41: google.bigtable.testproxy.GenerateConsistencyTokenRequest.request:type_name -> google.bigtable.admin.v2.GenerateConsistencyTokenRequest
+	50, // 42: google.bigtable.testproxy.GenerateConsistencyTokenResult.status:type_name -> google.rpc.Status
+	71, // 43: google.bigtable.testproxy.GenerateConsistencyTokenResult.result:type_name -> google.bigtable.admin.v2.GenerateConsistencyTokenResponse
+	72, // 44: google.bigtable.testproxy.CheckConsistencyRequest.request:type_name -> google.bigtable.admin.v2.CheckConsistencyRequest
+	50, // 45: google.bigtable.testproxy.CheckConsistencyResult.status:type_name -> google.rpc.Status
+	73, // 46: google.bigtable.testproxy.CheckConsistencyResult.result:type_name -> google.bigtable.admin.v2.CheckConsistencyResponse
+	74, // 47: google.bigtable.testproxy.ReadChangeStreamRequest.request:type_name -> google.bigtable.v2.ReadChangeStreamRequest
+	47, // 48: google.bigtable.testproxy.ChangeStreamRecord.mutation:type_name -> google.bigtable.testproxy.ChangeStreamRecord.ChangeStreamMutation
+	75, // 49: google.bigtable.testproxy.ChangeStreamRecord.heartbeat:type_name -> google.bigtable.v2.ReadChangeStreamResponse.Heartbeat
+	76, // 50: google.bigtable.testproxy.ChangeStreamRecord.close_stream:type_name -> google.bigtable.v2.ReadChangeStreamResponse.CloseStream
+	50, // 51: google.bigtable.testproxy.ReadChangeStreamResult.status:type_name -> google.rpc.Status
+	44, // 52: google.bigtable.testproxy.ReadChangeStreamResult.records:type_name -> google.bigtable.testproxy.ChangeStreamRecord
+	77, // 53: google.bigtable.testproxy.ChangeStreamRecord.ChangeStreamMutation.type:type_name -> google.bigtable.v2.ReadChangeStreamResponse.DataChange.Type
+	78, // 54: google.bigtable.testproxy.ChangeStreamRecord.ChangeStreamMutation.commit_timestamp:type_name -> google.protobuf.Timestamp
+	79, // 55: google.bigtable.testproxy.ChangeStreamRecord.ChangeStreamMutation.mutations:type_name -> google.bigtable.v2.Mutation
+	78, // 56: google.bigtable.testproxy.ChangeStreamRecord.ChangeStreamMutation.estimated_low_watermark:type_name -> google.protobuf.Timestamp
+	1,  // 57: google.bigtable.testproxy.CloudBigtableV2TestProxy.CreateClient:input_type -> google.bigtable.testproxy.CreateClientRequest
+	3,  // 58: google.bigtable.testproxy.CloudBigtableV2TestProxy.CloseClient:input_type -> google.bigtable.testproxy.CloseClientRequest
+	5,  // 59: google.bigtable.testproxy.CloudBigtableV2TestProxy.RemoveClient:input_type -> google.bigtable.testproxy.RemoveClientRequest
+	7,  // 60: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadRow:input_type -> google.bigtable.testproxy.ReadRowRequest
+	9,  // 61: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadRows:input_type -> google.bigtable.testproxy.ReadRowsRequest
+	11, // 62: google.bigtable.testproxy.CloudBigtableV2TestProxy.MutateRow:input_type -> google.bigtable.testproxy.MutateRowRequest
+	13, // 63: google.bigtable.testproxy.CloudBigtableV2TestProxy.BulkMutateRows:input_type -> google.bigtable.testproxy.MutateRowsRequest
+	16, // 64: google.bigtable.testproxy.CloudBigtableV2TestProxy.CreateBatcher:input_type -> google.bigtable.testproxy.CreateBatcherRequest
+	18, // 65: google.bigtable.testproxy.CloudBigtableV2TestProxy.AddBatcherEntries:input_type -> google.bigtable.testproxy.AddBatcherEntriesRequest
+	20, // 66: google.bigtable.testproxy.CloudBigtableV2TestProxy.FlushBatcher:input_type -> google.bigtable.testproxy.FlushBatcherRequest
+	21, // 67: google.bigtable.testproxy.CloudBigtableV2TestProxy.CloseBatcher:input_type -> google.bigtable.testproxy.CloseBatcherRequest
+	23, // 68: google.bigtable.testproxy.CloudBigtableV2TestProxy.CheckAndMutateRow:input_type -> google.bigtable.testproxy.CheckAndMutateRowRequest
+	25, // 69: google.bigtable.testproxy.CloudBigtableV2TestProxy.SampleRowKeys:input_type -> google.bigtable.testproxy.SampleRowKeysRequest
+	27, // 70: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadModifyWriteRow:input_type -> google.bigtable.testproxy.ReadModifyWriteRowRequest
+	28, // 71: google.bigtable.testproxy.CloudBigtableV2TestProxy.ExecuteQuery:input_type -> google.bigtable.testproxy.ExecuteQueryRequest
+	43, // 72: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadChangeStream:input_type -> google.bigtable.testproxy.ReadChangeStreamRequest
+	32, // 73: google.bigtable.testproxy.CloudBigtableV2TestProxy.CreateTable:input_type -> google.bigtable.testproxy.CreateTableRequest
+	34, // 74: google.bigtable.testproxy.CloudBigtableV2TestProxy.DeleteTable:input_type -> google.bigtable.testproxy.DeleteTableRequest
+	36, // 75: google.bigtable.testproxy.CloudBigtableV2TestProxy.ModifyColumnFamilies:input_type -> google.bigtable.testproxy.ModifyColumnFamiliesRequest
+	37, // 76: google.bigtable.testproxy.CloudBigtableV2TestProxy.DropRowRange:input_type -> google.bigtable.testproxy.DropRowRangeRequest
+	39, // 77: google.bigtable.testproxy.CloudBigtableV2TestProxy.GenerateConsistencyToken:input_type -> google.bigtable.testproxy.GenerateConsistencyTokenRequest
+	41, // 78: google.bigtable.testproxy.CloudBigtableV2TestProxy.CheckConsistency:input_type -> google.bigtable.testproxy.CheckConsistencyRequest
+	2,  // 79: google.bigtable.testproxy.CloudBigtableV2TestProxy.CreateClient:output_type -> google.bigtable.testproxy.CreateClientResponse
+	4,  // 80: google.bigtable.testproxy.CloudBigtableV2TestProxy.CloseClient:output_type -> google.bigtable.testproxy.CloseClientResponse
+	6,  // 81: google.bigtable.testproxy.CloudBigtableV2TestProxy.RemoveClient:output_type -> google.bigtable.testproxy.RemoveClientResponse
+	8,  // 82: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadRow:output_type -> google.bigtable.testproxy.RowResult
+	10, // 83: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadRows:output_type -> google.bigtable.testproxy.RowsResult
+	12, // 84: google.bigtable.testproxy.CloudBigtableV2TestProxy.MutateRow:output_type -> google.bigtable.testproxy.MutateRowResult
+	14, // 85: google.bigtable.testproxy.CloudBigtableV2TestProxy.BulkMutateRows:output_type -> google.bigtable.testproxy.MutateRowsResult
+	17, // 86: google.bigtable.testproxy.CloudBigtableV2TestProxy.CreateBatcher:output_type -> google.bigtable.testproxy.CreateBatcherResponse
+	19, // 87: google.bigtable.testproxy.CloudBigtableV2TestProxy.AddBatcherEntries:output_type -> google.bigtable.testproxy.AddBatcherEntriesResult
+	22, // 88: google.bigtable.testproxy.CloudBigtableV2TestProxy.FlushBatcher:output_type -> google.bigtable.testproxy.BatcherResult
+	22, // 89: google.bigtable.testproxy.CloudBigtableV2TestProxy.CloseBatcher:output_type -> google.bigtable.testproxy.BatcherResult
+	24, // 90: google.bigtable.testproxy.CloudBigtableV2TestProxy.CheckAndMutateRow:output_type -> google.bigtable.testproxy.CheckAndMutateRowResult
+	26, // 91: google.bigtable.testproxy.CloudBigtableV2TestProxy.SampleRowKeys:output_type -> google.bigtable.testproxy.SampleRowKeysResult
+	8,  // 92: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadModifyWriteRow:output_type -> google.bigtable.testproxy.RowResult
+	29, // 93: google.bigtable.testproxy.CloudBigtableV2TestProxy.ExecuteQuery:output_type -> google.bigtable.testproxy.ExecuteQueryResult
+	45, // 94: google.bigtable.testproxy.CloudBigtableV2TestProxy.ReadChangeStream:output_type -> google.bigtable.testproxy.ReadChangeStreamResult
+	33, // 95: google.bigtable.testproxy.CloudBigtableV2TestProxy.CreateTable:output_type -> google.bigtable.testproxy.TableResult
+	35, // 96: google.bigtable.testproxy.CloudBigtableV2TestProxy.DeleteTable:output_type -> google.bigtable.testproxy.DeleteTableResult
+	33, // 97: google.bigtable.testproxy.CloudBigtableV2TestProxy.ModifyColumnFamilies:output_type -> google.bigtable.testproxy.TableResult
+	38, // 98: google.bigtable.testproxy.CloudBigtableV2TestProxy.DropRowRange:output_type -> google.bigtable.testproxy.DropRowRangeResult
+	40, // 99: google.bigtable.testproxy.CloudBigtableV2TestProxy.GenerateConsistencyToken:output_type -> google.bigtable.testproxy.GenerateConsistencyTokenResult
+	42, // 100: google.bigtable.testproxy.CloudBigtableV2TestProxy.CheckConsistency:output_type -> google.bigtable.testproxy.CheckConsistencyResult
+	79, // [79:101] is the sub-list for method output_type
+	57, // [57:79] is the sub-list for method input_type
+	57, // [57:57] is the sub-list for extension type_name
+	57, // [57:57] is the sub-list for extension extendee
+	0,  // [0:57] is the sub-list for field type_name
 }
 
 func init() { file_test_proxy_proto_init() }
@@ -1857,13 +3828,18 @@ func file_test_proxy_proto_init() {
 	if File_test_proxy_proto != nil {
 		return
 	}
+	file_test_proxy_proto_msgTypes[43].OneofWrappers = []any{
+		(*ChangeStreamRecord_Mutation)(nil),
+		(*ChangeStreamRecord_Heartbeat)(nil),
+		(*ChangeStreamRecord_CloseStream)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_test_proxy_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   24,
+			NumMessages:   47,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
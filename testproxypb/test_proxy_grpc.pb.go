@@ -33,17 +33,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CloudBigtableV2TestProxy_CreateClient_FullMethodName       = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CreateClient"
-	CloudBigtableV2TestProxy_CloseClient_FullMethodName        = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CloseClient"
-	CloudBigtableV2TestProxy_RemoveClient_FullMethodName       = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/RemoveClient"
-	CloudBigtableV2TestProxy_ReadRow_FullMethodName            = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ReadRow"
-	CloudBigtableV2TestProxy_ReadRows_FullMethodName           = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ReadRows"
-	CloudBigtableV2TestProxy_MutateRow_FullMethodName          = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/MutateRow"
-	CloudBigtableV2TestProxy_BulkMutateRows_FullMethodName     = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/BulkMutateRows"
-	CloudBigtableV2TestProxy_CheckAndMutateRow_FullMethodName  = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CheckAndMutateRow"
-	CloudBigtableV2TestProxy_SampleRowKeys_FullMethodName      = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/SampleRowKeys"
-	CloudBigtableV2TestProxy_ReadModifyWriteRow_FullMethodName = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ReadModifyWriteRow"
-	CloudBigtableV2TestProxy_ExecuteQuery_FullMethodName       = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ExecuteQuery"
+	CloudBigtableV2TestProxy_CreateClient_FullMethodName             = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CreateClient"
+	CloudBigtableV2TestProxy_CloseClient_FullMethodName              = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CloseClient"
+	CloudBigtableV2TestProxy_RemoveClient_FullMethodName             = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/RemoveClient"
+	CloudBigtableV2TestProxy_ReadRow_FullMethodName                  = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ReadRow"
+	CloudBigtableV2TestProxy_ReadRows_FullMethodName                 = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ReadRows"
+	CloudBigtableV2TestProxy_MutateRow_FullMethodName                = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/MutateRow"
+	CloudBigtableV2TestProxy_BulkMutateRows_FullMethodName           = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/BulkMutateRows"
+	CloudBigtableV2TestProxy_CreateBatcher_FullMethodName            = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CreateBatcher"
+	CloudBigtableV2TestProxy_AddBatcherEntries_FullMethodName        = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/AddBatcherEntries"
+	CloudBigtableV2TestProxy_FlushBatcher_FullMethodName             = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/FlushBatcher"
+	CloudBigtableV2TestProxy_CloseBatcher_FullMethodName             = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CloseBatcher"
+	CloudBigtableV2TestProxy_CheckAndMutateRow_FullMethodName        = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CheckAndMutateRow"
+	CloudBigtableV2TestProxy_SampleRowKeys_FullMethodName            = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/SampleRowKeys"
+	CloudBigtableV2TestProxy_ReadModifyWriteRow_FullMethodName       = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ReadModifyWriteRow"
+	CloudBigtableV2TestProxy_ExecuteQuery_FullMethodName             = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ExecuteQuery"
+	CloudBigtableV2TestProxy_ReadChangeStream_FullMethodName         = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ReadChangeStream"
+	CloudBigtableV2TestProxy_CreateTable_FullMethodName              = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CreateTable"
+	CloudBigtableV2TestProxy_DeleteTable_FullMethodName              = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/DeleteTable"
+	CloudBigtableV2TestProxy_ModifyColumnFamilies_FullMethodName     = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/ModifyColumnFamilies"
+	CloudBigtableV2TestProxy_DropRowRange_FullMethodName             = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/DropRowRange"
+	CloudBigtableV2TestProxy_GenerateConsistencyToken_FullMethodName = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/GenerateConsistencyToken"
+	CloudBigtableV2TestProxy_CheckConsistency_FullMethodName         = "/google.bigtable.testproxy.CloudBigtableV2TestProxy/CheckConsistency"
 )
 
 // CloudBigtableV2TestProxyClient is the client API for CloudBigtableV2TestProxy service.
@@ -93,6 +104,18 @@ type CloudBigtableV2TestProxyClient interface {
 	MutateRow(ctx context.Context, in *MutateRowRequest, opts ...grpc.CallOption) (*MutateRowResult, error)
 	// Writes multiple rows with the client instance.
 	BulkMutateRows(ctx context.Context, in *MutateRowsRequest, opts ...grpc.CallOption) (*MutateRowsResult, error)
+	// Mutation batcher operations: the proxy is expected to use the batcher of
+	// the binding, which sends the added entries in MutateRows batches.
+	//
+	// Creates a mutation batcher with the client instance.
+	CreateBatcher(ctx context.Context, in *CreateBatcherRequest, opts ...grpc.CallOption) (*CreateBatcherResponse, error)
+	// Adds entries to a mutation batcher.
+	AddBatcherEntries(ctx context.Context, in *AddBatcherEntriesRequest, opts ...grpc.CallOption) (*AddBatcherEntriesResult, error)
+	// Sends the pending entries of a mutation batcher, and waits for all the
+	// added entries.
+	FlushBatcher(ctx context.Context, in *FlushBatcherRequest, opts ...grpc.CallOption) (*BatcherResult, error)
+	// Flushes and closes a mutation batcher.
+	CloseBatcher(ctx context.Context, in *CloseBatcherRequest, opts ...grpc.CallOption) (*BatcherResult, error)
 	// Performs a check-and-mutate-row operation with the client instance.
 	CheckAndMutateRow(ctx context.Context, in *CheckAndMutateRowRequest, opts ...grpc.CallOption) (*CheckAndMutateRowResult, error)
 	// Obtains a row key sampling with the client instance.
@@ -101,6 +124,25 @@ type CloudBigtableV2TestProxyClient interface {
 	ReadModifyWriteRow(ctx context.Context, in *ReadModifyWriteRowRequest, opts ...grpc.CallOption) (*RowResult, error)
 	// Executes a BTQL query with the client.
 	ExecuteQuery(ctx context.Context, in *ExecuteQueryRequest, opts ...grpc.CallOption) (*ExecuteQueryResult, error)
+	// Reads a change stream with the client instance. The records are returned
+	// after being decoded by the client binding.
+	ReadChangeStream(ctx context.Context, in *ReadChangeStreamRequest, opts ...grpc.CallOption) (*ReadChangeStreamResult, error)
+	// Table admin operations: the proxy is expected to send them to the same
+	// `data_target` as the Bigtable operations, using the table admin client of
+	// the binding.
+	//
+	// Creates a table with the client instance.
+	CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*TableResult, error)
+	// Deletes a table with the client instance.
+	DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResult, error)
+	// Creates, updates or drops column families with the client instance.
+	ModifyColumnFamilies(ctx context.Context, in *ModifyColumnFamiliesRequest, opts ...grpc.CallOption) (*TableResult, error)
+	// Drops a row range of a table with the client instance.
+	DropRowRange(ctx context.Context, in *DropRowRangeRequest, opts ...grpc.CallOption) (*DropRowRangeResult, error)
+	// Generates a consistency token with the client instance.
+	GenerateConsistencyToken(ctx context.Context, in *GenerateConsistencyTokenRequest, opts ...grpc.CallOption) (*GenerateConsistencyTokenResult, error)
+	// Checks the replication consistency with the client instance.
+	CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*CheckConsistencyResult, error)
 }
 
 type cloudBigtableV2TestProxyClient struct {
@@ -181,6 +223,46 @@ func (c *cloudBigtableV2TestProxyClient) BulkMutateRows(ctx context.Context, in
 	return out, nil
 }
 
+func (c *cloudBigtableV2TestProxyClient) CreateBatcher(ctx context.Context, in *CreateBatcherRequest, opts ...grpc.CallOption) (*CreateBatcherResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateBatcherResponse)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_CreateBatcher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) AddBatcherEntries(ctx context.Context, in *AddBatcherEntriesRequest, opts ...grpc.CallOption) (*AddBatcherEntriesResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddBatcherEntriesResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_AddBatcherEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) FlushBatcher(ctx context.Context, in *FlushBatcherRequest, opts ...grpc.CallOption) (*BatcherResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatcherResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_FlushBatcher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) CloseBatcher(ctx context.Context, in *CloseBatcherRequest, opts ...grpc.CallOption) (*BatcherResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatcherResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_CloseBatcher_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cloudBigtableV2TestProxyClient) CheckAndMutateRow(ctx context.Context, in *CheckAndMutateRowRequest, opts ...grpc.CallOption) (*CheckAndMutateRowResult, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CheckAndMutateRowResult)
@@ -221,6 +303,76 @@ func (c *cloudBigtableV2TestProxyClient) ExecuteQuery(ctx context.Context, in *E
 	return out, nil
 }
 
+func (c *cloudBigtableV2TestProxyClient) ReadChangeStream(ctx context.Context, in *ReadChangeStreamRequest, opts ...grpc.CallOption) (*ReadChangeStreamResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadChangeStreamResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_ReadChangeStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) CreateTable(ctx context.Context, in *CreateTableRequest, opts ...grpc.CallOption) (*TableResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_CreateTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) DeleteTable(ctx context.Context, in *DeleteTableRequest, opts ...grpc.CallOption) (*DeleteTableResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteTableResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_DeleteTable_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) ModifyColumnFamilies(ctx context.Context, in *ModifyColumnFamiliesRequest, opts ...grpc.CallOption) (*TableResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TableResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_ModifyColumnFamilies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) DropRowRange(ctx context.Context, in *DropRowRangeRequest, opts ...grpc.CallOption) (*DropRowRangeResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DropRowRangeResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_DropRowRange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) GenerateConsistencyToken(ctx context.Context, in *GenerateConsistencyTokenRequest, opts ...grpc.CallOption) (*GenerateConsistencyTokenResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateConsistencyTokenResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_GenerateConsistencyToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cloudBigtableV2TestProxyClient) CheckConsistency(ctx context.Context, in *CheckConsistencyRequest, opts ...grpc.CallOption) (*CheckConsistencyResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckConsistencyResult)
+	err := c.cc.Invoke(ctx, CloudBigtableV2TestProxy_CheckConsistency_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CloudBigtableV2TestProxyServer is the server API for CloudBigtableV2TestProxy service.
 // All implementations must embed UnimplementedCloudBigtableV2TestProxyServer
 // for forward compatibility.
@@ -268,6 +420,18 @@ type CloudBigtableV2TestProxyServer interface {
 	MutateRow(context.Context, *MutateRowRequest) (*MutateRowResult, error)
 	// Writes multiple rows with the client instance.
 	BulkMutateRows(context.Context, *MutateRowsRequest) (*MutateRowsResult, error)
+	// Mutation batcher operations: the proxy is expected to use the batcher of
+	// the binding, which sends the added entries in MutateRows batches.
+	//
+	// Creates a mutation batcher with the client instance.
+	CreateBatcher(context.Context, *CreateBatcherRequest) (*CreateBatcherResponse, error)
+	// Adds entries to a mutation batcher.
+	AddBatcherEntries(context.Context, *AddBatcherEntriesRequest) (*AddBatcherEntriesResult, error)
+	// Sends the pending entries of a mutation batcher, and waits for all the
+	// added entries.
+	FlushBatcher(context.Context, *FlushBatcherRequest) (*BatcherResult, error)
+	// Flushes and closes a mutation batcher.
+	CloseBatcher(context.Context, *CloseBatcherRequest) (*BatcherResult, error)
 	// Performs a check-and-mutate-row operation with the client instance.
 	CheckAndMutateRow(context.Context, *CheckAndMutateRowRequest) (*CheckAndMutateRowResult, error)
 	// Obtains a row key sampling with the client instance.
@@ -276,6 +440,25 @@ type CloudBigtableV2TestProxyServer interface {
 	ReadModifyWriteRow(context.Context, *ReadModifyWriteRowRequest) (*RowResult, error)
 	// Executes a BTQL query with the client.
 	ExecuteQuery(context.Context, *ExecuteQueryRequest) (*ExecuteQueryResult, error)
+	// Reads a change stream with the client instance. The records are returned
+	// after being decoded by the client binding.
+	ReadChangeStream(context.Context, *ReadChangeStreamRequest) (*ReadChangeStreamResult, error)
+	// Table admin operations: the proxy is expected to send them to the same
+	// `data_target` as the Bigtable operations, using the table admin client of
+	// the binding.
+	//
+	// Creates a table with the client instance.
+	CreateTable(context.Context, *CreateTableRequest) (*TableResult, error)
+	// Deletes a table with the client instance.
+	DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResult, error)
+	// Creates, updates or drops column families with the client instance.
+	ModifyColumnFamilies(context.Context, *ModifyColumnFamiliesRequest) (*TableResult, error)
+	// Drops a row range of a table with the client instance.
+	DropRowRange(context.Context, *DropRowRangeRequest) (*DropRowRangeResult, error)
+	// Generates a consistency token with the client instance.
+	GenerateConsistencyToken(context.Context, *GenerateConsistencyTokenRequest) (*GenerateConsistencyTokenResult, error)
+	// Checks the replication consistency with the client instance.
+	CheckConsistency(context.Context, *CheckConsistencyRequest) (*CheckConsistencyResult, error)
 	mustEmbedUnimplementedCloudBigtableV2TestProxyServer()
 }
 
@@ -307,6 +490,18 @@ func (UnimplementedCloudBigtableV2TestProxyServer) MutateRow(context.Context, *M
 func (UnimplementedCloudBigtableV2TestProxyServer) BulkMutateRows(context.Context, *MutateRowsRequest) (*MutateRowsResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BulkMutateRows not implemented")
 }
+func (UnimplementedCloudBigtableV2TestProxyServer) CreateBatcher(context.Context, *CreateBatcherRequest) (*CreateBatcherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBatcher not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) AddBatcherEntries(context.Context, *AddBatcherEntriesRequest) (*AddBatcherEntriesResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBatcherEntries not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) FlushBatcher(context.Context, *FlushBatcherRequest) (*BatcherResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushBatcher not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) CloseBatcher(context.Context, *CloseBatcherRequest) (*BatcherResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseBatcher not implemented")
+}
 func (UnimplementedCloudBigtableV2TestProxyServer) CheckAndMutateRow(context.Context, *CheckAndMutateRowRequest) (*CheckAndMutateRowResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckAndMutateRow not implemented")
 }
@@ -319,6 +514,27 @@ func (UnimplementedCloudBigtableV2TestProxyServer) ReadModifyWriteRow(context.Co
 func (UnimplementedCloudBigtableV2TestProxyServer) ExecuteQuery(context.Context, *ExecuteQueryRequest) (*ExecuteQueryResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExecuteQuery not implemented")
 }
+func (UnimplementedCloudBigtableV2TestProxyServer) ReadChangeStream(context.Context, *ReadChangeStreamRequest) (*ReadChangeStreamResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadChangeStream not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) CreateTable(context.Context, *CreateTableRequest) (*TableResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTable not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) DeleteTable(context.Context, *DeleteTableRequest) (*DeleteTableResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTable not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) ModifyColumnFamilies(context.Context, *ModifyColumnFamiliesRequest) (*TableResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyColumnFamilies not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) DropRowRange(context.Context, *DropRowRangeRequest) (*DropRowRangeResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRowRange not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) GenerateConsistencyToken(context.Context, *GenerateConsistencyTokenRequest) (*GenerateConsistencyTokenResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateConsistencyToken not implemented")
+}
+func (UnimplementedCloudBigtableV2TestProxyServer) CheckConsistency(context.Context, *CheckConsistencyRequest) (*CheckConsistencyResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckConsistency not implemented")
+}
 func (UnimplementedCloudBigtableV2TestProxyServer) mustEmbedUnimplementedCloudBigtableV2TestProxyServer() {
 }
 func (UnimplementedCloudBigtableV2TestProxyServer) testEmbeddedByValue() {}
@@ -467,6 +683,78 @@ func _CloudBigtableV2TestProxy_BulkMutateRows_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudBigtableV2TestProxy_CreateBatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBatcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).CreateBatcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_CreateBatcher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).CreateBatcher(ctx, req.(*CreateBatcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_AddBatcherEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBatcherEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).AddBatcherEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_AddBatcherEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).AddBatcherEntries(ctx, req.(*AddBatcherEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_FlushBatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushBatcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).FlushBatcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_FlushBatcher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).FlushBatcher(ctx, req.(*FlushBatcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_CloseBatcher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseBatcherRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).CloseBatcher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_CloseBatcher_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).CloseBatcher(ctx, req.(*CloseBatcherRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CloudBigtableV2TestProxy_CheckAndMutateRow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CheckAndMutateRowRequest)
 	if err := dec(in); err != nil {
@@ -539,6 +827,132 @@ func _CloudBigtableV2TestProxy_ExecuteQuery_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CloudBigtableV2TestProxy_ReadChangeStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadChangeStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).ReadChangeStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_ReadChangeStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).ReadChangeStream(ctx, req.(*ReadChangeStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_CreateTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).CreateTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_CreateTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).CreateTable(ctx, req.(*CreateTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_DeleteTable_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTableRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).DeleteTable(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_DeleteTable_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).DeleteTable(ctx, req.(*DeleteTableRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_ModifyColumnFamilies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyColumnFamiliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).ModifyColumnFamilies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_ModifyColumnFamilies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).ModifyColumnFamilies(ctx, req.(*ModifyColumnFamiliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_DropRowRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRowRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).DropRowRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_DropRowRange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).DropRowRange(ctx, req.(*DropRowRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_GenerateConsistencyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateConsistencyTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).GenerateConsistencyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_GenerateConsistencyToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).GenerateConsistencyToken(ctx, req.(*GenerateConsistencyTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CloudBigtableV2TestProxy_CheckConsistency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckConsistencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CloudBigtableV2TestProxyServer).CheckConsistency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CloudBigtableV2TestProxy_CheckConsistency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CloudBigtableV2TestProxyServer).CheckConsistency(ctx, req.(*CheckConsistencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CloudBigtableV2TestProxy_ServiceDesc is the grpc.ServiceDesc for CloudBigtableV2TestProxy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -574,6 +988,22 @@ var CloudBigtableV2TestProxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BulkMutateRows",
 			Handler:    _CloudBigtableV2TestProxy_BulkMutateRows_Handler,
 		},
+		{
+			MethodName: "CreateBatcher",
+			Handler:    _CloudBigtableV2TestProxy_CreateBatcher_Handler,
+		},
+		{
+			MethodName: "AddBatcherEntries",
+			Handler:    _CloudBigtableV2TestProxy_AddBatcherEntries_Handler,
+		},
+		{
+			MethodName: "FlushBatcher",
+			Handler:    _CloudBigtableV2TestProxy_FlushBatcher_Handler,
+		},
+		{
+			MethodName: "CloseBatcher",
+			Handler:    _CloudBigtableV2TestProxy_CloseBatcher_Handler,
+		},
 		{
 			MethodName: "CheckAndMutateRow",
 			Handler:    _CloudBigtableV2TestProxy_CheckAndMutateRow_Handler,
@@ -590,6 +1020,34 @@ var CloudBigtableV2TestProxy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ExecuteQuery",
 			Handler:    _CloudBigtableV2TestProxy_ExecuteQuery_Handler,
 		},
+		{
+			MethodName: "ReadChangeStream",
+			Handler:    _CloudBigtableV2TestProxy_ReadChangeStream_Handler,
+		},
+		{
+			MethodName: "CreateTable",
+			Handler:    _CloudBigtableV2TestProxy_CreateTable_Handler,
+		},
+		{
+			MethodName: "DeleteTable",
+			Handler:    _CloudBigtableV2TestProxy_DeleteTable_Handler,
+		},
+		{
+			MethodName: "ModifyColumnFamilies",
+			Handler:    _CloudBigtableV2TestProxy_ModifyColumnFamilies_Handler,
+		},
+		{
+			MethodName: "DropRowRange",
+			Handler:    _CloudBigtableV2TestProxy_DropRowRange_Handler,
+		},
+		{
+			MethodName: "GenerateConsistencyToken",
+			Handler:    _CloudBigtableV2TestProxy_GenerateConsistencyToken_Handler,
+		},
+		{
+			MethodName: "CheckConsistency",
+			Handler:    _CloudBigtableV2TestProxy_CheckConsistency_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "test_proxy.proto",
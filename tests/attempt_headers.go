@@ -0,0 +1,163 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the checks of the headers that a client sends on every
+// attempt of an operation, as recorded by the mock server. Besides the client
+// and resource info, the attempts carry an attempt counter and an operation ID,
+// which let the server tell the retries of an operation apart. Not every client
+// sends the latter two, so they are only required with -enable_features_all.
+package tests
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const (
+	// attemptHeader is the zero-based index of an attempt within its operation.
+	attemptHeader = "bigtable-attempt"
+	// invocationIDPrefix prefixes the operation ID in x-goog-api-client, which is the same across
+	// the attempts of an operation.
+	invocationIDPrefix = "gccl-invocation-id/"
+	// routingCookieHeader is the routing cookie returned by the mock server, which the client
+	// should send back on the retries.
+	routingCookieHeader = "x-goog-cbt-cookie-test"
+)
+
+// attemptHeaders is the expected headers of the attempts of an operation.
+type attemptHeaders struct {
+	params  map[string]string // The expected x-goog-request-params, before URL escaping.
+	cookies []string          // The routing cookie of each attempt, where "" or a missing one means none.
+}
+
+// requestParams parses the x-goog-request-params `header`. Unlike url.ParseQuery, it fails on the
+// unescaped slashes, which a client must escape in the resource names.
+func requestParams(header string) (map[string]string, error) {
+	params := make(map[string]string)
+	for _, param := range strings.Split(header, "&") {
+		key, value, _ := strings.Cut(param, "=")
+		if strings.Contains(value, "/") {
+			return nil, fmt.Errorf("%s has an unescaped slash", key)
+		}
+		unescaped, err := url.QueryUnescape(value)
+		if err != nil {
+			return nil, err
+		}
+		params[key] = unescaped
+	}
+	return params, nil
+}
+
+// invocationID returns the operation ID in the x-goog-api-client `values`, "" if there is none.
+func invocationID(values []string) string {
+	for _, value := range values {
+		for _, token := range strings.Fields(value) {
+			if id, ok := strings.CutPrefix(token, invocationIDPrefix); ok {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// checkAttemptHeaders checks the headers of every attempt of `method` for the operation with ID
+// `opID` ("" matches any) at the mock server `s` against `want`. It returns the number of attempts.
+func checkAttemptHeaders(t *testing.T, s *Server, method string, opID string, want attemptHeaders) int {
+	records := s.callRecords(method, opID)
+	if len(records) == 0 {
+		t.Errorf("No %s attempt to check the headers", method)
+		return 0
+	}
+
+	var counters, ids []string
+	for i, record := range records {
+		// 1. The client info and the resource info
+		md := record.md
+		if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
+			t.Errorf("%s attempt %d has no client info in the header", method, i+1)
+		}
+		if values := md["x-goog-request-params"]; len(values) != 1 {
+			t.Errorf("%s attempt %d has %d x-goog-request-params, want 1", method, i+1, len(values))
+		} else if params, err := requestParams(values[0]); err != nil {
+			t.Errorf("%s attempt %d has malformed x-goog-request-params %q: %v", method, i+1, values[0], err)
+		} else {
+			for key, value := range want.params {
+				if params[key] != value {
+					t.Errorf("%s attempt %d has %s %q in x-goog-request-params, want %q", method, i+1, key, params[key], value)
+				}
+			}
+		}
+
+		// 2. The routing cookie, which is only sent on the retries
+		var wantCookie []string
+		if i < len(want.cookies) && want.cookies[i] != "" {
+			wantCookie = []string{want.cookies[i]}
+		}
+		if got := md[routingCookieHeader]; len(got) != 0 || len(wantCookie) != 0 {
+			if strings.Join(got, ",") != strings.Join(wantCookie, ",") {
+				t.Errorf("%s attempt %d has routing cookie %q, want %q", method, i+1, got, wantCookie)
+			}
+		}
+
+		counters = append(counters, strings.Join(md[attemptHeader], ","))
+		ids = append(ids, invocationID(md["x-goog-api-client"]))
+	}
+
+	// 3. The attempt counter, which increments from 0
+	if sent := checkSentOnAll(t, method, attemptHeader, counters); sent {
+		for i, counter := range counters {
+			if counter != "" && counter != strconv.Itoa(i) {
+				t.Errorf("%s attempt %d has %s %q, want %d", method, i+1, attemptHeader, counter, i)
+			}
+		}
+	}
+
+	// 4. The operation ID, which is the same across the attempts
+	if sent := checkSentOnAll(t, method, invocationIDPrefix, ids); sent {
+		for i, id := range ids {
+			if id != "" && id != ids[0] {
+				t.Errorf("%s attempt %d has operation ID %q, but attempt 1 has %q", method, i+1, id, ids[0])
+			}
+		}
+	}
+	return len(records)
+}
+
+// checkSentOnAll checks that the optional header `name`, whose value in each attempt of `method`
+// is in `values`, is sent on all the attempts or none of them. A header that is never sent is
+// only an error with -enable_features_all. It returns whether the header is sent at all.
+func checkSentOnAll(t *testing.T, method string, name string, values []string) bool {
+	sent := 0
+	for _, value := range values {
+		if value != "" {
+			sent++
+		}
+	}
+	switch {
+	case sent == 0 && *enableFeaturesAll:
+		t.Errorf("%s attempts have no %s", method, name)
+	case sent == 0:
+		t.Logf("%s attempts have no %s, which is only required with --enable_features_all", method, name)
+	case sent < len(values):
+		for i, value := range values {
+			if value == "" {
+				t.Errorf("%s attempt %d has no %s, unlike the other attempts", method, i+1, name)
+			}
+		}
+	}
+	return sent > 0
+}
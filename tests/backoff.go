@@ -0,0 +1,254 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the backoff analyzer, which checks the retry delays that
+// the mock server observes against a model of exponential backoff with jitter:
+// the n-th retry waits for a random delay in [jitterMin, jitterMax] times the
+// nominal delay min(initial * multiplier^(n-1), max). Clients differ in these
+// parameters, so the bounds come from a profile selected by -backoff_profile,
+// and the backoff tests fail without one.
+package tests
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+// backoffSlack is added to the bounds of the delays for the latency of the client and the network.
+const backoffSlack = 50 * time.Millisecond
+
+// backoffGridSize is the number of values of the initial delay and of the multiplier to try when
+// fitting the delays.
+const backoffGridSize = 64
+
+// backoffBounds is the bounds of the backoff parameters of a client profile. The initial delay
+// and the multiplier are ranges, as they may not be exactly known.
+type backoffBounds struct {
+	initialMin, initialMax       time.Duration
+	multiplierMin, multiplierMax float64
+	max                          time.Duration // The cap of the nominal delay.
+	jitterMin, jitterMax         float64       // The range of a delay relative to the nominal delay.
+}
+
+// backoffMinTotalRatio is the least ratio of the total of the retry delays to the total expected
+// with the least initial delay and multiplier of the profile, at the middle of the jitter range.
+// Without it, a client that doesn't wait at all would fit a profile with full jitter, as each
+// delay may then be zero. With full jitter, three retries fall below it with a chance of about
+// 0.1%.
+const backoffMinTotalRatio = 0.125
+
+// backoffDefaults are the bounds of the parameters omitted in -backoff_profile, which cover the
+// known clients, from the full jitter of Go to the 2x jitter of Python. They are too loose to be a
+// profile on their own, as they fit a client that doesn't wait at all.
+const backoffDefaults = "initial=1ms-1s,multiplier=1.1-4,max=1m,jitter=0-2"
+
+// backoffProfiles are the predefined profiles of -backoff_profile.
+var backoffProfiles = map[string]string{
+	// "go" is the retry policy of cloud.google.com/go/bigtable, with the full jitter of gax-go.
+	"go": "initial=100ms,multiplier=1.2,max=2s,jitter=0-1",
+}
+
+// parseBackoffProfile parses -backoff_profile, which is either the name of a predefined profile, or
+// the parameters, e.g., "initial=10ms-100ms,multiplier=2,max=1m,jitter=0-1". A parameter is either
+// a value or a "min-max" range, and the omitted ones are taken from `backoffDefaults`. It returns
+// nil if `value` is empty.
+func parseBackoffProfile(value string) (*backoffBounds, error) {
+	if value == "" {
+		return nil, nil
+	}
+	if spec, ok := backoffProfiles[value]; ok {
+		value = spec
+	}
+	p := &backoffBounds{}
+	if err := p.set(backoffDefaults); err != nil {
+		return nil, err
+	}
+	if err := p.set(value); err != nil {
+		return nil, fmt.Errorf("invalid backoff profile %q: %v", value, err)
+	}
+	if p.initialMin <= 0 || p.initialMin > p.initialMax || p.multiplierMin < 1 ||
+		p.multiplierMin > p.multiplierMax || p.max <= 0 || p.jitterMin < 0 || p.jitterMin > p.jitterMax {
+		return nil, fmt.Errorf("invalid backoff profile %q: inconsistent bounds", value)
+	}
+	return p, nil
+}
+
+// set sets the parameters of the comma-separated `spec`.
+func (p *backoffBounds) set(spec string) error {
+	for _, param := range strings.Split(spec, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			return fmt.Errorf("parameter %q is not key=value", param)
+		}
+		lo, hi, isRange := strings.Cut(value, "-")
+		if !isRange {
+			hi = lo
+		}
+		var err error
+		switch key {
+		case "initial":
+			if p.initialMin, err = time.ParseDuration(lo); err == nil {
+				p.initialMax, err = time.ParseDuration(hi)
+			}
+		case "multiplier":
+			if p.multiplierMin, err = strconv.ParseFloat(lo, 64); err == nil {
+				p.multiplierMax, err = strconv.ParseFloat(hi, 64)
+			}
+		case "max":
+			p.max, err = time.ParseDuration(value)
+		case "jitter":
+			if p.jitterMin, err = strconv.ParseFloat(lo, 64); err == nil {
+				p.jitterMax, err = strconv.ParseFloat(hi, 64)
+			}
+		default:
+			return fmt.Errorf("unknown parameter %q", key)
+		}
+		if err != nil {
+			return fmt.Errorf("parameter %q: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// nominal returns the nominal delay of the n-th retry, which is one-based.
+func (p *backoffBounds) nominal(initial time.Duration, multiplier float64, n int) time.Duration {
+	d := float64(initial) * math.Pow(multiplier, float64(n-1))
+	return time.Duration(math.Min(d, float64(p.max)))
+}
+
+// bounds returns the range of the delay of the n-th retry for the given parameters.
+func (p *backoffBounds) bounds(initial time.Duration, multiplier float64, n int) (time.Duration, time.Duration) {
+	nominal := float64(p.nominal(initial, multiplier, n))
+	lo := time.Duration(nominal*p.jitterMin) - backoffSlack
+	hi := time.Duration(nominal*p.jitterMax) + backoffSlack
+	return max(lo, 0), hi
+}
+
+// fits tells if all the `delays` are within the bounds of the given parameters.
+func (p *backoffBounds) fits(delays []time.Duration, initial time.Duration, multiplier float64) bool {
+	for i, d := range delays {
+		lo, hi := p.bounds(initial, multiplier, i+1)
+		if d < lo || d > hi {
+			return false
+		}
+	}
+	return true
+}
+
+// gridValue returns the i-th of the `backoffGridSize` values between `lo` and `hi`, which are
+// spaced geometrically.
+func gridValue(lo, hi float64, i int) float64 {
+	if lo == hi {
+		return lo
+	}
+	return lo * math.Pow(hi/lo, float64(i)/float64(backoffGridSize-1))
+}
+
+// fit returns the parameters within the profile that fit the `delays` best, i.e., that have all
+// the delays within their bounds, and the least squared log error against the delays at the middle
+// of the jitter range. It returns false if there are no such parameters.
+func (p *backoffBounds) fit(delays []time.Duration) (time.Duration, float64, bool) {
+	midJitter := (p.jitterMin + p.jitterMax) / 2
+	var best struct {
+		initial    time.Duration
+		multiplier float64
+		err        float64
+	}
+	found := false
+	for i := 0; i < backoffGridSize; i++ {
+		initial := time.Duration(gridValue(float64(p.initialMin), float64(p.initialMax), i))
+		for j := 0; j < backoffGridSize; j++ {
+			multiplier := gridValue(p.multiplierMin, p.multiplierMax, j)
+			if !p.fits(delays, initial, multiplier) {
+				continue
+			}
+			var err float64
+			for k, d := range delays {
+				// A millisecond is added to both sides, so that the zero delays of full jitter count.
+				want := float64(p.nominal(initial, multiplier, k+1))*midJitter + float64(time.Millisecond)
+				err += math.Pow(math.Log(float64(d+time.Millisecond)/want), 2)
+			}
+			if !found || err < best.err {
+				best.initial, best.multiplier, best.err = initial, multiplier, err
+				found = true
+			}
+		}
+	}
+	return best.initial, best.multiplier, found
+}
+
+// minTotal returns the least total of the delays of `n` retries, see backoffMinTotalRatio.
+func (p *backoffBounds) minTotal(n int) time.Duration {
+	var total time.Duration
+	for i := 1; i <= n; i++ {
+		total += p.nominal(p.initialMin, p.multiplierMin, i)
+	}
+	return time.Duration(float64(total) * (p.jitterMin + p.jitterMax) / 2 * backoffMinTotalRatio)
+}
+
+// retryDelays returns the delays between the consecutive attempts of `records`, i.e., from the end
+// of an attempt to the start of the next one.
+func retryDelays(records []*callRecord) []time.Duration {
+	var delays []time.Duration
+	for i := 1; i < len(records); i++ {
+		delays = append(delays, records[i].start.Sub(records[i-1].end))
+	}
+	return delays
+}
+
+// checkRetryBackoff checks that the delays between the attempts of `method` for the operation
+// with ID `opID` ("" matches any) at the mock server `s` fit the backoff of -backoff_profile.
+func checkRetryBackoff(t *testing.T, s *Server, method string, opID string) {
+	if backoff == nil {
+		t.Errorf("No -backoff_profile to check the %s retry backoff against", method)
+		return
+	}
+	delays := retryDelays(s.callRecords(method, opID))
+	if len(delays) == 0 {
+		t.Errorf("No %s retry to check the backoff", method)
+		return
+	}
+	var total time.Duration
+	for _, d := range delays {
+		total += d
+	}
+	if least := backoff.minTotal(len(delays)); total < least {
+		t.Errorf("%s retry delays %v total %v, less than the %v that the backoff of the profile implies",
+			method, delays, total, least)
+		return
+	}
+	if initial, multiplier, ok := backoff.fit(delays); ok {
+		t.Logf("%s retry delays %v fit initial delay %v and multiplier %.2f", method, delays,
+			initial.Round(time.Millisecond), multiplier)
+		return
+	}
+
+	// Report the delays beyond the loosest bounds of the profile, if any.
+	reported := false
+	for i, d := range delays {
+		lo, _ := backoff.bounds(backoff.initialMin, backoff.multiplierMin, i+1)
+		_, hi := backoff.bounds(backoff.initialMax, backoff.multiplierMax, i+1)
+		if d < lo || d > hi {
+			t.Errorf("%s retry #%d delay %v is out of the backoff bounds [%v, %v]", method, i+1, d, lo, hi)
+			reported = true
+		}
+	}
+	if !reported {
+		t.Errorf("%s retry delays %v don't fit a single exponential backoff of the profile", method, delays)
+	}
+}
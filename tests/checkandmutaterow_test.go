@@ -29,7 +29,6 @@ import (
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -71,13 +70,9 @@ func TestCheckAndMutateRow_Generic_Headers(t *testing.T) {
 	tableName := buildTableName(tableID)
 
 	// 1. Instantiate the mock server
-	// Don't call mockCheckAndMutateRowFn() as the behavior is to record metadata of the request
-	mdRecords := make(chan metadata.MD, 1)
+	// The server records the metadata of every request, so the mock function only responds
 	server := initMockServer(t)
 	server.CheckAndMutateRowFn = func(ctx context.Context, req *btpb.CheckAndMutateRowRequest) (*btpb.CheckAndMutateRowResponse, error) {
-		md, _ := metadata.FromIncomingContext(ctx)
-		mdRecords <- md
-
 		return &btpb.CheckAndMutateRowResponse{PredicateMatched: predicateMatched}, nil
 	}
 
@@ -94,7 +89,7 @@ func TestCheckAndMutateRow_Generic_Headers(t *testing.T) {
 	doCheckAndMutateRowOp(t, server, &req, &opts)
 
 	// 4. Check the request headers in the metadata
-	md := <-mdRecords
+	md := requireCallRecords(t, server, "CheckAndMutateRow", "", 1)[0].md
 	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -363,4 +358,35 @@ func TestCheckAndMutateRow_Generic_DeadlineExceeded(t *testing.T) {
 
 	// 4b. Check the DeadlineExceeded error
 	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4c. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "CheckAndMutateRow", "", 2*time.Second)
+}
+
+// TestCheckAndMutateRow_Generic_SecureConnection tests that client can conditionally mutate a row
+// on a TLS-enabled server.
+func TestCheckAndMutateRow_Generic_SecureConnection(t *testing.T) {
+	// 0. Common variable
+	const predicateMatched bool = true
+	clientReq := dummyCheckAndMutateRowRequest("table", []byte("row-01"), predicateMatched, 1)
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *checkAndMutateRowReqRecord, 1)
+	action := &checkAndMutateRowAction{predicateMatched: predicateMatched}
+	server := initMockServerWithTLS(t, nil)
+	server.CheckAndMutateRowFn = mockCheckAndMutateRowFnSimple(recorder, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.CheckAndMutateRowRequest{
+		ClientId: t.Name(),
+		Request:  clientReq,
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doCheckAndMutateRowOp(t, server, &req, tlsClientOpts(server, ""))
+
+	// 4. Check that the operation succeeded over the secure connection
+	checkResultOkStatus(t, res)
+	assert.True(t, res.GetResult().GetPredicateMatched())
+	assert.Equal(t, 1, len(recorder))
 }
@@ -0,0 +1,104 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the checks of the built-in client-side metrics, which a
+// client created with a metrics_target exports to the mock server, see
+// mock_metrics.go. The metrics are exported at the latest when the client is
+// closed, so the checks run after the operations are done.
+package tests
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	monitoringpb "cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"google.golang.org/grpc/codes"
+)
+
+// metricPoint is the expected value of a client metric for the attempts or the operations that
+// end with a status.
+type metricPoint struct {
+	name   string // Metric name, e.g., "attempt_latencies".
+	status codes.Code
+	value  int64   // The count of a distribution, or the value of a counter. 0 also matches no value.
+	mean   float64 // The mean of a distribution, e.g., in milliseconds. 0 means it isn't checked.
+}
+
+// clientMetrics is the expected client metrics of a method.
+type clientMetrics struct {
+	method   string            // The method label, e.g., "Bigtable.ReadRows".
+	profile  string            // The app_profile label.
+	resource map[string]string // The expected resource labels, e.g., "cluster".
+	points   []metricPoint
+}
+
+// sameStatus tells if the status `label` of a metric names `code`. The clients spell the codes
+// differently, e.g., "UNAVAILABLE" or "Unavailable", so case and underscores are ignored.
+func sameStatus(label string, code codes.Code) bool {
+	return strings.EqualFold(strings.ReplaceAll(label, "_", ""), code.String())
+}
+
+// metricMeanSlack is the tolerance of the mean of a distribution, e.g., for the rounding of the
+// server-timing header to milliseconds.
+const metricMeanSlack = 1.0
+
+// metricValue returns the count of the distribution or the value of the counter in the latest
+// point of `ts`, and the mean of the distribution.
+func metricValue(ts *monitoringpb.TimeSeries) (int64, float64) {
+	if len(ts.GetPoints()) == 0 {
+		return 0, 0
+	}
+	value := ts.GetPoints()[0].GetValue()
+	if d := value.GetDistributionValue(); d != nil {
+		return d.GetCount(), d.GetMean()
+	}
+	return value.GetInt64Value(), 0
+}
+
+// checkClientMetrics checks the client metrics exported to the mock server `s` against `want`.
+// The values of each point are summed over the time series with its method and status, and the
+// means are averaged.
+func checkClientMetrics(t *testing.T, s *Server, want clientMetrics) {
+	for _, point := range want.points {
+		var value int64
+		var sum float64
+		found := false
+		for _, ts := range s.timeSeries(point.name) {
+			labels := ts.GetMetric().GetLabels()
+			if labels["method"] != want.method || !sameStatus(labels["status"], point.status) {
+				continue
+			}
+			found = true
+			count, mean := metricValue(ts)
+			value += count
+			sum += float64(count) * mean
+			if labels["app_profile"] != want.profile {
+				t.Errorf("%s of %s has app_profile %q, want %q", point.name, want.method, labels["app_profile"], want.profile)
+			}
+			for key, wantValue := range want.resource {
+				if got := ts.GetResource().GetLabels()[key]; got != wantValue {
+					t.Errorf("%s of %s has resource label %s %q, want %q", point.name, want.method, key, got, wantValue)
+				}
+			}
+		}
+		if !found && point.value != 0 {
+			t.Errorf("No %s of %s with status %v is exported", point.name, want.method, point.status)
+		} else if value != point.value {
+			t.Errorf("%s of %s with status %v is %d, want %d", point.name, want.method, point.status, value, point.value)
+		} else if mean := sum / float64(value); point.mean != 0 && value != 0 && math.Abs(mean-point.mean) > metricMeanSlack {
+			t.Errorf("%s of %s with status %v has mean %v, want %v", point.name, want.method, point.status, mean, point.mean)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the checks of the deadline propagation. A client should
+// send the time left of the operation timeout in the grpc-timeout header of
+// every attempt, so that the server can stop working for a client that has
+// given up. The mock server records the deadline of each attempt, see
+// mock_recorder.go.
+package tests
+
+import (
+	"testing"
+	"time"
+)
+
+// deadlineSlack is the tolerance of the deadline checks for the latency between the test, the
+// test proxy and the mock server.
+const deadlineSlack = 100 * time.Millisecond
+
+// checkAttemptDeadlines checks the attempts of `method` for the operation with ID `opID` ("" matches
+// any) at the mock server `s`, where the operation has the timeout `opTimeout`. Every attempt should
+// have a deadline that doesn't exceed the operation deadline, the time left should shrink across
+// the retries, and no attempt should start after the operation deadline. It returns the number of
+// attempts.
+//
+// The operation deadline is counted from the start of the first attempt, as the test only knows
+// when it sends the operation to the test proxy, which may first have to set up the client. The
+// client starts the operation a bit before its first attempt, so the bound is a bit loose.
+func checkAttemptDeadlines(t *testing.T, s *Server, method string, opID string, opTimeout time.Duration) int {
+	records := s.callRecords(method, opID)
+	if len(records) == 0 {
+		t.Errorf("No %s attempt to check the deadline", method)
+		return 0
+	}
+	opDeadline := records[0].start.Add(opTimeout)
+	for i, record := range records {
+		if record.start.After(opDeadline.Add(deadlineSlack)) {
+			t.Errorf("%s attempt %d starts %v after the operation deadline", method, i+1, record.start.Sub(opDeadline))
+		}
+		if record.deadline.IsZero() {
+			t.Errorf("%s attempt %d has no grpc-timeout", method, i+1)
+			continue
+		}
+		if record.deadline.After(opDeadline.Add(deadlineSlack)) {
+			t.Errorf("%s attempt %d has a deadline %v after the operation deadline", method, i+1, record.deadline.Sub(opDeadline))
+		}
+		if i == 0 || records[i-1].deadline.IsZero() {
+			continue
+		}
+		left, prevLeft := record.deadline.Sub(record.start), records[i-1].deadline.Sub(records[i-1].start)
+		if left >= prevLeft {
+			t.Errorf("%s attempt %d has %v left, which doesn't shrink from the %v of attempt %d", method, i+1, left, prevLeft, i)
+		}
+	}
+	return len(records)
+}
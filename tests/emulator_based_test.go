@@ -36,7 +36,7 @@ import (
 )
 
 // createTableInEmulator creates a table with the given table id and family id.
-// As the test proxy doesn't support admin APIs, use go client here.
+// As not every test proxy supports the table admin APIs yet, use go client here.
 func createTableInEmulator(addr string, tableID string, familyID string) error {
 	// Connect to the emulator
 	conn, err := grpc.Dial(addr, grpc.WithInsecure())
@@ -22,6 +22,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -30,8 +31,8 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -523,6 +524,8 @@ func TestExecuteQuery_QueryParams(t *testing.T) {
 
 // Tests that a query runs successfully when results are chunked within a single response stream
 func TestExecuteQuery_ChunkingTest(t *testing.T) {
+	skipIfReplaying(t, "the values of the rows are random")
+
 	// 1. Instantiate the mock server with columns containing potentially large data
 	server := initMockServer(t)
 	columns := []*btpb.ColumnMetadata{
@@ -587,6 +590,8 @@ func TestExecuteQuery_ChunkingTest(t *testing.T) {
 
 // Tests that a query runs successfully when results are split across multiple response streams (batches)
 func TestExecuteQuery_BatchesTest(t *testing.T) {
+	skipIfReplaying(t, "the values of the rows are random")
+
 	// 1. Instantiate the mock server with columns containing potentially large data
 	server := initMockServer(t)
 	columns := []*btpb.ColumnMetadata{
@@ -741,6 +746,8 @@ func TestExecuteQuery_FailsOnExecuteQueryMetadata(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"Response contains unknown type metadata",
+		// Go error message
+		"unexpected response type",
 	})
 }
 
@@ -814,6 +821,8 @@ func TestExecuteQuery_FailsOnNotEnoughData(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"received incomplete row",
+		// Go error message
+		"metadata and data mismatch",
 	})
 }
 
@@ -854,6 +863,8 @@ func TestExecuteQuery_FailsOnNotEnoughDataWithCompleteRows(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"received incomplete row",
+		// Go error message
+		"metadata and data mismatch",
 	})
 }
 
@@ -893,6 +904,8 @@ func TestExecuteQuery_FailsOnTypeMismatch(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"Metadata and Value not matching",
+		// Go error message
+		"expected StringValue for StringType",
 	})
 }
 
@@ -932,6 +945,8 @@ func TestExecuteQuery_FailsOnTypeMismatchWithinMap(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"Metadata and Value not matching",
+		// Go error message
+		"expected IntValue for Int64Type",
 	})
 }
 
@@ -971,6 +986,8 @@ func TestExecuteQuery_FailsOnTypeMismatchWithinArray(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"Metadata and Value not matching",
+		// Go error message
+		"expected StringValue for StringType",
 	})
 }
 
@@ -1011,6 +1028,8 @@ func TestExecuteQuery_FailsOnTypeMismatchWithinStruct(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"Metadata and Value not matching",
+		// Go error message
+		"expected IntValue for Int64Type",
 	})
 }
 
@@ -1050,6 +1069,8 @@ func TestExecuteQuery_FailsOnStructMissingField(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"received Struct with 1 values, but metadata has 2 fields",
+		// Go error message
+		"struct data/schema mismatch",
 	})
 }
 
@@ -1165,11 +1186,9 @@ func TestExecuteQuery_FailsOnSuccesfulStreamWithNoToken(t *testing.T) {
 
 // Tests that appropriate request headers (routing, client info) are set for PrepareQuery and ExecuteQuery calls
 func TestExecuteQuery_HeadersAreSet(t *testing.T) {
-	// 1. Instantiate the mock server and recorders for requests and metadata
+	// 1. Instantiate the mock server
 	server := initMockServer(t)
-	prepareRecorder := make(chan *prepareQueryReqRecord, 1)
-	prepareMdRecorder := make(chan metadata.MD, 1)
-	server.PrepareQueryFn = mockPrepareQueryFnWithMetadata(prepareRecorder, prepareMdRecorder,
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
 		&prepareQueryAction{
 			response: prepareResponse([]byte("foo"), md(
 				column("strCol", strType()),
@@ -1177,9 +1196,7 @@ func TestExecuteQuery_HeadersAreSet(t *testing.T) {
 			)),
 		},
 	)
-	executeRecorder := make(chan *executeQueryReqRecord, 1)
-	executeMdRecorder := make(chan metadata.MD, 1)
-	server.ExecuteQueryFn = mockExecuteQueryFnWithMetadataSimple(executeRecorder, executeMdRecorder,
+	server.ExecuteQueryFn = mockExecuteQueryFn(nil,
 		&executeQueryAction{
 			response:    partialResultSet("token", strVal("foo"), intVal(100)),
 			endOfStream: true,
@@ -1210,7 +1227,7 @@ func TestExecuteQuery_HeadersAreSet(t *testing.T) {
 	assertRowEqual(t, testProxyRow(strVal("foo"), intVal(100)), res.Rows[0], res.Metadata)
 
 	// Check the request headers in the prepare metadata
-	prepareHeaders := <-prepareMdRecorder
+	prepareHeaders := requireCallRecords(t, server, "PrepareQuery", "", 1)[0].md
 	if len(prepareHeaders["user-agent"]) == 0 && len(prepareHeaders["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -1222,7 +1239,7 @@ func TestExecuteQuery_HeadersAreSet(t *testing.T) {
 	assert.Contains(t, prepareResource, appProfileId) // Check for app profile
 
 	// Check the request headers in the execute metadata
-	executeHeaders := <-executeMdRecorder
+	executeHeaders := requireCallRecords(t, server, "ExecuteQuery", "", 1)[0].md
 	if len(executeHeaders["user-agent"]) == 0 && len(executeHeaders["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -1234,6 +1251,141 @@ func TestExecuteQuery_HeadersAreSet(t *testing.T) {
 	assert.Contains(t, executeResource, appProfileId) // Check for app profile
 }
 
+// Tests that every attempt of PrepareQuery and ExecuteQuery, including the retries, has the client
+// and resource info, an incrementing attempt counter and a stable operation ID.
+func TestExecuteQuery_RetryTest_HeadersAreSet(t *testing.T) {
+	// 1. Instantiate the mock server, which fails the first attempt of both RPCs
+	server := initMockServer(t)
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
+		&prepareQueryAction{rpcError: codes.Unavailable},
+		&prepareQueryAction{
+			response: prepareResponse([]byte("foo"), md(
+				column("strCol", strType()),
+			)),
+		},
+	)
+	server.ExecuteQueryFn = mockExecuteQueryFn(nil,
+		&executeQueryAction{rpcError: codes.Unavailable},
+		&executeQueryAction{
+			response:    partialResultSet("token", strVal("foo")),
+			endOfStream: true,
+		})
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+	}
+	appProfileId := "headers-test"
+	opts := clientOpts{
+		profile: appProfileId,
+	}
+	// 3. Perform the operation via test proxy with client options
+	res := doExecuteQueryOp(t, server, &req, &opts)
+	// 4. Verify the operation succeeds after the retries, and check the headers of every attempt
+	checkResultOkStatus(t, res)
+	want := attemptHeaders{
+		params: map[string]string{"name": instanceName, "app_profile_id": appProfileId},
+	}
+	assert.Equal(t, 2, checkAttemptHeaders(t, server, "PrepareQuery", "", want))
+	assert.Equal(t, 2, checkAttemptHeaders(t, server, "ExecuteQuery", "", want))
+}
+
+// Tests that every attempt of both PrepareQuery and ExecuteQuery, including the retries, propagates
+// the trace of the operation in a span of its own
+func TestExecuteQuery_RetryTest_TraceContext(t *testing.T) {
+	parent := newTraceContext()
+	// 1. Instantiate the mock server, which fails the first attempt of both RPCs
+	server := initMockServer(t)
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
+		&prepareQueryAction{rpcError: codes.Unavailable},
+		&prepareQueryAction{
+			response: prepareResponse([]byte("foo"), md(
+				column("strCol", strType()),
+			)),
+		},
+	)
+	server.ExecuteQueryFn = mockExecuteQueryFn(nil,
+		&executeQueryAction{rpcError: codes.Unavailable},
+		&executeQueryAction{
+			response:    partialResultSet("token", strVal("foo")),
+			endOfStream: true,
+		})
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+		Traceparent: parent.traceparent(),
+	}
+	// 3. Perform the operation via test proxy
+	res := doExecuteQueryOp(t, server, &req, nil)
+	// 4. Verify the operation succeeds after the retries, and the PrepareQuery attempts are spans
+	// in the trace of the operation, apart from the ExecuteQuery attempts
+	checkResultOkStatus(t, res)
+	prepareSpans := checkAttemptTraces(t, server, "PrepareQuery", "", parent)
+	executeSpans := checkAttemptTraces(t, server, "ExecuteQuery", "", parent)
+	assert.Equal(t, 2, len(prepareSpans))
+	assert.Equal(t, 2, len(executeSpans))
+	for _, span := range prepareSpans {
+		assert.NotContains(t, executeSpans, span, "PrepareQuery and ExecuteQuery attempts share a span")
+	}
+}
+
+// Tests that the PrepareQuery that refreshes an expired query plan propagates the trace of the
+// ExecuteQuery operation
+func TestExecuteQuery_PlanRefresh_TraceContext(t *testing.T) {
+	parent := newTraceContext()
+	// 1. Instantiate the mock server, where the first query plan expires
+	server := initMockServer(t)
+	columns := []*btpb.ColumnMetadata{
+		column("strCol", strType()),
+	}
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
+		&prepareQueryAction{
+			response: prepareResponse([]byte("query0"), md(columns...)),
+		},
+		&prepareQueryAction{
+			response: prepareResponse([]byte("query1"), md(columns...)),
+		},
+	)
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(nil,
+		map[string][]*executeQueryAction{
+			"query0": []*executeQueryAction{
+				&executeQueryAction{
+					apiError: prepareRefreshError(),
+				},
+			},
+			"query1": []*executeQueryAction{
+				&executeQueryAction{
+					response:    partialResultSet("token", strVal("foo")),
+					endOfStream: true,
+				},
+			},
+		},
+	)
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+		Traceparent: parent.traceparent(),
+	}
+	// 3. Perform the operation via test proxy
+	res := doExecuteQueryOp(t, server, &req, nil)
+	// 4. Verify the operation succeeds after the refresh, and both PrepareQuery calls are in the
+	// trace of the operation
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 2, len(checkAttemptTraces(t, server, "PrepareQuery", "", parent)))
+	assert.Equal(t, 2, len(checkAttemptTraces(t, server, "ExecuteQuery", "", parent)))
+}
+
 // Tests that the ExecuteQuery RPC respects the client-specified deadline/timeout
 func TestExecuteQuery_ExecuteQueryRespectsDeadline(t *testing.T) {
 	// 1. Instantiate the mock server with a delay in ExecuteQuery longer than the client timeout
@@ -1279,6 +1431,9 @@ func TestExecuteQuery_ExecuteQueryRespectsDeadline(t *testing.T) {
 		msg := res.GetStatus().GetMessage()
 		assert.Contains(t, strings.ToLower(strings.ReplaceAll(msg, " ", "")), "deadlineexceeded")
 	}
+
+	// 5. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "ExecuteQuery", "", 2*time.Second)
 }
 
 // Tests that the PrepareQuery RPC respects the client-specified deadline/timeout
@@ -1322,6 +1477,98 @@ func TestExecuteQuery_PrepareQueryRespectsDeadline(t *testing.T) {
 		msg := res.GetStatus().GetMessage()
 		assert.Contains(t, strings.ToLower(strings.ReplaceAll(msg, " ", "")), "deadlineexceeded")
 	}
+
+	// 5. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "PrepareQuery", "", 2*time.Second)
+}
+
+// TestExecuteQuery_RetryTest_ExecuteQueryRespectsDeadline tests that client sends what is left of the timeout
+// in the grpc-timeout of every attempt, and stops retrying once the timeout is reached.
+func TestExecuteQuery_RetryTest_ExecuteQueryRespectsDeadline(t *testing.T) {
+	// 0. Common variables
+	const maxRPCs int = 10 // More than the attempts that fit in the timeout.
+	const timeout = 2 * time.Second
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
+		&prepareQueryAction{
+			response: prepareResponse([]byte("foo"), md(
+				column("test", strType()),
+			)),
+		},
+	)
+	var actions []*executeQueryAction
+	for i := 0; i < maxRPCs; i++ {
+		actions = append(actions, &executeQueryAction{rpcError: codes.Unavailable, delayStr: "500ms"})
+	}
+	server.ExecuteQueryFn = mockExecuteQueryFn(nil, actions...)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: durationpb.New(timeout),
+	}
+	res := doExecuteQueryOp(t, server, &req, &opts)
+
+	// 4a. Check the DeadlineExceeded error
+	// Some clients wrap the error code in the message, so check the message if error code is not right.
+	if res.GetStatus().GetCode() != int32(codes.DeadlineExceeded) {
+		msg := res.GetStatus().GetMessage()
+		assert.Contains(t, strings.ToLower(strings.ReplaceAll(msg, " ", "")), "deadlineexceeded")
+	}
+
+	// 4b. Check the deadlines of the attempts, and that the client did retry
+	assert.Greater(t, checkAttemptDeadlines(t, server, "ExecuteQuery", "", timeout), 1)
+}
+
+// TestExecuteQuery_RetryTest_PrepareQueryRespectsDeadline tests that client sends what is left of the timeout
+// in the grpc-timeout of every attempt, and stops retrying once the timeout is reached.
+func TestExecuteQuery_RetryTest_PrepareQueryRespectsDeadline(t *testing.T) {
+	// 0. Common variables
+	const maxRPCs int = 10 // More than the attempts that fit in the timeout.
+	const timeout = 2 * time.Second
+
+	// 1. Instantiate the mock server
+	var actions []*prepareQueryAction
+	for i := 0; i < maxRPCs; i++ {
+		actions = append(actions, &prepareQueryAction{rpcError: codes.Unavailable, delayStr: "500ms"})
+	}
+	server := initMockServer(t)
+	server.PrepareQueryFn = mockPrepareQueryFn(nil, actions...)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: durationpb.New(timeout),
+	}
+	res := doExecuteQueryOp(t, server, &req, &opts)
+
+	// 4a. Check the DeadlineExceeded error
+	// Some clients wrap the error code in the message, so check the message if error code is not right.
+	if res.GetStatus().GetCode() != int32(codes.DeadlineExceeded) {
+		msg := res.GetStatus().GetMessage()
+		assert.Contains(t, strings.ToLower(strings.ReplaceAll(msg, " ", "")), "deadlineexceeded")
+	}
+
+	// 4b. Check the deadlines of the attempts, and that the client did retry
+	assert.Greater(t, checkAttemptDeadlines(t, server, "PrepareQuery", "", timeout), 1)
 }
 
 func TestExecuteQuery_ConcurrentRequests(t *testing.T) {
@@ -1394,7 +1641,7 @@ func TestExecuteQuery_ConcurrentRequests(t *testing.T) {
 			},
 		},
 	}
-	server.ExecuteQueryFn = mockExecuteQueryFnWithMetadata(recorder, nil, actionSequences)
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(recorder, actionSequences)
 
 	reqs := make([]*testproxypb.ExecuteQueryRequest, concurrency)
 	for i := 0; i < concurrency; i++ {
@@ -1450,6 +1697,70 @@ func TestExecuteQuery_ConcurrentRequests(t *testing.T) {
 	assertRowEqual(t, testProxyRow(arrayVal(strVal("f1"), strVal("f2"))), results[4].Rows[1], results[4].Metadata)
 }
 
+// Tests that clients with different app profiles can run the same query concurrently, and each
+// query gets its own response. The requests are routed by app profile, as ExecuteQuery requests
+// have no table name.
+func TestExecuteQuery_ConcurrentRequestsPerAppProfile(t *testing.T) {
+	// 0. Common variables
+	profiles := []string{"profile0", "profile1"}
+	clientIDs := []string{t.Name() + "_0", t.Name() + "_1"}
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.PrepareQueryFn = mockPrepareQueryFnWithMatchingQuery(nil, map[string]*prepareQueryAction{
+		"SELECT * FROM table": &prepareQueryAction{
+			response: prepareResponse([]byte("foo"), md(column("strCol", strType()))),
+		},
+	})
+	server.ExecuteQueryFn = routeStreamFn("ExecuteQuery", routeByAppProfile,
+		map[string]func(*btpb.ExecuteQueryRequest, btpb.Bigtable_ExecuteQueryServer) error{
+			profiles[0]: mockExecuteQueryFn(nil, &executeQueryAction{
+				response:    partialResultSet("token", strVal("foo")),
+				delayStr:    "1s",
+				endOfStream: true,
+			}),
+			profiles[1]: mockExecuteQueryFn(nil, &executeQueryAction{
+				rpcError: codes.PermissionDenied,
+				delayStr: "1s",
+			}),
+		})
+
+	// 2. Create the clients
+	server.Start()
+	defer server.Close()
+	for i, clientID := range clientIDs {
+		createCbtClient(t, clientID, server.Addr, &clientOpts{profile: profiles[i]})
+		defer removeCbtClient(t, clientID)
+		defer closeCbtClient(t, clientID)
+	}
+
+	// 3. Perform the operations via test proxy concurrently
+	results := make([]*testproxypb.ExecuteQueryResult, len(clientIDs))
+	var wg sync.WaitGroup
+	for i, clientID := range clientIDs {
+		wg.Add(1)
+		go func(i int, clientID string) {
+			defer wg.Done()
+			req := &testproxypb.ExecuteQueryRequest{
+				ClientId: clientID,
+				Request: &btpb.ExecuteQueryRequest{
+					InstanceName: instanceName,
+					Query:        "SELECT * FROM table",
+				},
+			}
+			results[i] = doExecuteQueryOpsCore(t, clientID, []*testproxypb.ExecuteQueryRequest{req}, nil)[0]
+		}(i, clientID)
+	}
+	wg.Wait()
+
+	// 4. Check that each client gets the response for its app profile
+	checkResultOkStatus(t, results[0])
+	if assert.Equal(t, 1, len(results[0].GetRows())) {
+		assertRowEqual(t, testProxyRow(strVal("foo")), results[0].Rows[0], results[0].Metadata)
+	}
+	assert.Equal(t, int32(codes.PermissionDenied), results[1].GetStatus().GetCode())
+}
+
 // tests that client doesn't kill inflight requests after client closing, but will reject new requests.
 func TestExecuteQuery_CloseClient(t *testing.T) {
 	clientID := t.Name()
@@ -1495,7 +1806,7 @@ func TestExecuteQuery_CloseClient(t *testing.T) {
 		"query2": repeatedExecuteAction,
 		"query3": repeatedExecuteAction,
 	}
-	server.ExecuteQueryFn = mockExecuteQueryFnWithMetadata(executeRecorder, nil, actionSequences)
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(executeRecorder, actionSequences)
 
 	// Will be finished
 	reqsBatchOne := []*testproxypb.ExecuteQueryRequest{
@@ -1621,6 +1932,49 @@ func TestExecuteQuery_RetryTest_FirstResponse(t *testing.T) {
 	assert.Equal(t, []byte("p1"), req1.req.GetPreparedQuery())
 }
 
+// Tests that a query retries using exponential backoff, within the bounds of -backoff_profile.
+func TestExecuteQuery_RetryTest_ExponentialBackoff(t *testing.T) {
+	// 1. Instantiate the mock server
+	const numRPCs int = 4
+	server := initMockServer(t)
+	columns := []*btpb.ColumnMetadata{
+		column("strCol", strType()),
+	}
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
+		&prepareQueryAction{
+			response: prepareResponse([]byte("p1"), md(columns...)),
+		},
+	)
+	server.ExecuteQueryFn = mockExecuteQueryFn(nil,
+		// The first attempts fail with a retryable error
+		&executeQueryAction{rpcError: codes.Unavailable},
+		&executeQueryAction{rpcError: codes.Unavailable},
+		&executeQueryAction{rpcError: codes.Unavailable},
+		// The last attempt succeeds
+		&executeQueryAction{
+			response:    partialResultSet("token", strVal("foo")),
+			endOfStream: true,
+		},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doExecuteQueryOp(t, server, &req, nil)
+
+	// 4. Verify the operation succeeds after the retries, which are delayed by the backoff
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRPCs, len(server.callRecords("ExecuteQuery", "")))
+	checkRetryBackoff(t, server, "ExecuteQuery", "")
+}
+
 // Tests that a query retries successfully when a retryable error occurs mid-stream.
 func TestExecuteQuery_RetryTest_MidStream(t *testing.T) {
 	// 1. Instantiate the mock server
@@ -1854,6 +2208,8 @@ func TestExecuteQuery_RetryTest_ErrorAfterFinalData(t *testing.T) {
 }
 
 func TestExecuteQuery_RetryTest_ResetPartialBatch(t *testing.T) {
+	skipIfReplaying(t, "the values of the rows are random")
+
 	// 1. Instantiate the mock server
 	server := initMockServer(t)
 	columns := []*btpb.ColumnMetadata{
@@ -1920,6 +2276,8 @@ func TestExecuteQuery_RetryTest_ResetPartialBatch(t *testing.T) {
 }
 
 func TestExecuteQuery_RetryTest_ResetCompleteBatch(t *testing.T) {
+	skipIfReplaying(t, "the values of the rows are random")
+
 	// 1. Instantiate the mock server
 	server := initMockServer(t)
 	columns := []*btpb.ColumnMetadata{
@@ -2046,6 +2404,8 @@ func TestExecuteQuery_ChecksumMismatch(t *testing.T) {
 		"InvalidExecuteQueryResponse",
 		// Nodejs error message
 		"Failed to validate next batch of results",
+		// Go error message
+		"batch_checksum mismatch",
 	})
 }
 
@@ -2079,7 +2439,7 @@ func TestExecuteQuery_RetryTest_WithPlanRefresh(t *testing.T) {
 	chunkData, checksum := splitIntoChunks(3, expectedValues...)
 	executeRecorder := make(chan *executeQueryReqRecord, 3) // Expect 3 execute calls
 	token := "resume1"
-	server.ExecuteQueryFn = mockExecuteQueryFnWithMetadata(executeRecorder, nil,
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(executeRecorder,
 		map[string][]*executeQueryAction{
 			"query0": []*executeQueryAction{
 				&executeQueryAction{
@@ -2169,7 +2529,7 @@ func TestExecuteQuery_PlanRefresh(t *testing.T) {
 	chunkData, checksum := splitIntoChunks(3, expectedValues...)
 	executeRecorder := make(chan *executeQueryReqRecord, 2)
 	token := "resume1"
-	server.ExecuteQueryFn = mockExecuteQueryFnWithMetadata(executeRecorder, nil,
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(executeRecorder,
 		map[string][]*executeQueryAction{
 			"query0": []*executeQueryAction{
 				// Trigger plan refresh after retry
@@ -2255,7 +2615,7 @@ func TestExecuteQuery_PlanRefresh_WithMetadataChange(t *testing.T) {
 	chunkData, checksum := splitIntoChunks(3, expectedValues...)
 	executeRecorder := make(chan *executeQueryReqRecord, 2)
 	token := "resume1"
-	server.ExecuteQueryFn = mockExecuteQueryFnWithMetadata(executeRecorder, nil,
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(executeRecorder,
 		map[string][]*executeQueryAction{
 			"query0": []*executeQueryAction{
 				// Trigger plan refresh after retry
@@ -2468,7 +2828,7 @@ func TestExecuteQuery_PlanRefresh_Retries(t *testing.T) {
 	chunkData, checksum := splitIntoChunks(3, expectedValues...)
 	executeRecorder := make(chan *executeQueryReqRecord, 2)
 	token := "resume1"
-	server.ExecuteQueryFn = mockExecuteQueryFnWithMetadata(executeRecorder, nil,
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(executeRecorder,
 		map[string][]*executeQueryAction{
 			"query0": []*executeQueryAction{
 				// Trigger plan refresh after retry
@@ -2560,7 +2920,7 @@ func TestExecuteQuery_PlanRefresh_RecoversAfterPermanentError(t *testing.T) {
 	chunkData, checksum := splitIntoChunks(3, expectedValues...)
 	executeRecorder := make(chan *executeQueryReqRecord, 2)
 	token := "resume1"
-	server.ExecuteQueryFn = mockExecuteQueryFnWithMetadata(executeRecorder, nil,
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(executeRecorder,
 		map[string][]*executeQueryAction{
 			"query0": []*executeQueryAction{
 				// Trigger plan refresh after retry
@@ -2615,3 +2975,174 @@ func TestExecuteQuery_PlanRefresh_RecoversAfterPermanentError(t *testing.T) {
 	assert.Nil(t, req1.req.GetResumeToken())
 	assert.Equal(t, []byte("query1"), req2.req.GetPreparedQuery())
 }
+
+// Tests that a query resumes successfully when the stream is reset by RST_STREAM mid-stream.
+func TestExecuteQuery_RetryTest_RstStreamMidStream(t *testing.T) {
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	columns := []*btpb.ColumnMetadata{
+		column("strCol", strType()),
+	}
+	expectedValues := []*btpb.Value{
+		strVal("foo"),
+		strVal("bar"), // This chunk will be received after retry
+		strVal("baz"),
+	}
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
+		&prepareQueryAction{
+			response: prepareResponse([]byte("p1"), md(columns...)),
+		},
+	)
+
+	chunk1Data, checksum1 := splitIntoChunks(1, expectedValues[0])
+	chunk2Data, checksum2 := splitIntoChunks(2, expectedValues[1:]...)
+
+	executeRecorder := make(chan *executeQueryReqRecord, 2) // Expect 2 execute calls
+	token1 := "resume1"
+	token2 := "resume2"
+	server.ExecuteQueryFn = mockExecuteQueryFn(executeRecorder,
+		// First attempt sends the first chunk with a resume token
+		&executeQueryAction{
+			response:    prsFromBytes(chunk1Data[0], true, &token1, checksum1),
+			endOfStream: false,
+		},
+		// Then the stream is reset before the next response reaches the client
+		&executeQueryAction{
+			response:    prsFromBytes(chunk2Data[0], true, nil, nil),
+			endOfStream: true,
+		},
+		// Second attempt (resume) succeeds, sending remaining data
+		&executeQueryAction{
+			response:    prsFromBytes(chunk2Data[0], true, nil, nil),
+			endOfStream: false,
+		},
+		&executeQueryAction{
+			response:    prsFromBytes(chunk2Data[1], false, &token2, checksum2),
+			endOfStream: true,
+		},
+	)
+	server.injectTransportFaults(t, &transportFault{
+		kind:          rstStream,
+		method:        "ExecuteQuery",
+		attempt:       1,
+		afterMessages: 1,
+		errCode:       http2.ErrCodeInternal,
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doExecuteQueryOp(t, server, &req, nil)
+
+	// 4. Verify the operation succeeds after retry and gets all expected data combined
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 2, len(executeRecorder), "Expected ExecuteQuery to be called twice")
+	assert.Equal(t, len(res.Rows), 3)
+	assertRowEqual(t, testProxyRow(expectedValues[0]), res.Rows[0], res.Metadata)
+	assertRowEqual(t, testProxyRow(expectedValues[1]), res.Rows[1], res.Metadata)
+	assertRowEqual(t, testProxyRow(expectedValues[2]), res.Rows[2], res.Metadata)
+
+	// Check that the resume request used the token
+	<-executeRecorder
+	req2 := <-executeRecorder
+	assert.Equal(t, []byte(token1), req2.req.GetResumeToken())
+}
+
+// Tests that a query will run successfully against a TLS-enabled server
+func TestExecuteQuery_SecureConnection(t *testing.T) {
+	// 1. Instantiate the mock server
+	server := initMockServerWithTLS(t, nil)
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
+		&prepareQueryAction{
+			response: prepareResponse([]byte("foo"), md(column("test", strType()))),
+		},
+	)
+	server.ExecuteQueryFn = mockExecuteQueryFn(nil,
+		&executeQueryAction{
+			response:    partialResultSet("token", strVal("foo")),
+			endOfStream: true,
+		})
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+	}
+	// 3. Perform the operation via test proxy
+	res := doExecuteQueryOp(t, server, &req, tlsClientOpts(server, ""))
+	// 4. Verify the query succeeds over the secure connection
+	checkResultOkStatus(t, res)
+	assert.Equal(t, len(res.Rows), 1)
+	assertRowEqual(t, testProxyRow(strVal("foo")), res.Rows[0], res.Metadata)
+}
+
+// Tests that the access token is attached to the PrepareQuery requests of a plan refresh, as well
+// as to the ExecuteQuery requests
+func TestExecuteQuery_PlanRefresh_AccessToken(t *testing.T) {
+	// 1. Instantiate the mock server, which rejects the requests without the token
+	const accessToken = "test-token"
+	server := initMockServerWithTLS(t, nil)
+	server.requireAccessToken(accessToken)
+	columns := []*btpb.ColumnMetadata{
+		column("strCol", strType()),
+	}
+	server.PrepareQueryFn = mockPrepareQueryFn(nil,
+		&prepareQueryAction{
+			response: prepareResponse([]byte("query0"), md(columns...)),
+		},
+		&prepareQueryAction{
+			response: prepareResponse([]byte("query1"), md(columns...)),
+		},
+	)
+	executeRecorder := make(chan *executeQueryReqRecord, 2)
+	token := "resume1"
+	server.ExecuteQueryFn = mockExecuteQueryFnWithSequences(executeRecorder,
+		map[string][]*executeQueryAction{
+			"query0": []*executeQueryAction{
+				// Trigger plan refresh
+				&executeQueryAction{
+					apiError: prepareRefreshError(),
+				},
+			},
+			"query1": []*executeQueryAction{
+				&executeQueryAction{
+					response:    partialResultSet(token, strVal("foo")),
+					endOfStream: true,
+				},
+			},
+		},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ExecuteQueryRequest{
+		ClientId: t.Name(),
+		Request: &btpb.ExecuteQueryRequest{
+			InstanceName: instanceName,
+			Query:        "SELECT * FROM table",
+		},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := tlsClientOpts(server, "")
+	opts.security.AccessToken = accessToken
+	res := doExecuteQueryOp(t, server, &req, opts)
+
+	// 4. Verify the operation succeeds after the plan refresh, and every request carried the token
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 2, len(executeRecorder), "Expected ExecuteQuery to be called twice")
+	assert.Equal(t, len(res.Rows), 1)
+	authRecords := server.authRecords()
+	assert.Equal(t, 4, len(authRecords), "Expected 2 PrepareQuery and 2 ExecuteQuery requests")
+	for _, record := range authRecords {
+		assert.Equal(t, "Bearer "+accessToken, record.authorization, "%s request", record.method)
+	}
+}
@@ -18,10 +18,8 @@ package tests
 import (
 	"flag"
 	"log"
-	"net"
 	"os"
 	"testing"
-	"time"
 
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"google.golang.org/grpc"
@@ -30,46 +28,111 @@ import (
 var proxyAddr = flag.String("proxy_addr", "",
 	"The address of the test proxy server, which exports the CloudBigtableV2TestProxy "+
 		"service and should be running already. host:port address is expected. "+
-		":port also works as the proxy server is local.")
+		":port also works as the proxy server is local. Several proxies can be named in a "+
+		"comma-separated list, e.g., \"java=:9999,go=:9998\", in which case the tests run once "+
+		"against each of them in turn, and TestScenarios also reports where they behave differently.")
+var proxyCmd = flag.String("proxy_cmd", "",
+	"If set, the tests will start the test proxy with this command line, where \"{port}\" is "+
+		"replaced by the port to listen on (also passed in the PROXY_PORT environment variable). "+
+		"The port of -proxy_addr is used if set, or a free port otherwise. The proxy is restarted "+
+		"if it crashes, and the affected test fails as a proxy failure.")
+var proxyLogDir = flag.String("proxy_log_dir", "",
+	"If set with -proxy_cmd, the output of the test proxy during each test will be written to a "+
+		"file named after the test in this directory.")
 var printClientReq = flag.Bool("print_client_req", false,
 	"If enabled, server will print its received requests from the client. It helps debugging, "+
 		"but is quite verbose. Default to false.")
 var enableFeaturesAll = flag.Bool("enable_features_all", false,
-	"If enabled, client will enable all the optional features before sending out requests.")
+	"If enabled, client will enable all the optional features before sending out requests, and "+
+		"the tests will require the optional headers, e.g., the attempt counter.")
+var recordDir = flag.String("record_dir", "",
+	"If set, the mock servers will record the calls they receive, and each test will write the "+
+		"recording to a JSON file named after the test in this existing directory.")
+var replayDir = flag.String("replay_dir", "",
+	"If set, the mock servers will serve the calls recorded in this directory by -record_dir, "+
+		"and the tests will fail if the requests differ from the recorded ones.")
+var backoffProfile = flag.String("backoff_profile", "",
+	"The bounds of the retry backoff of the client, which is either a predefined profile (\"go\"), "+
+		"or the parameters, e.g., \"initial=10ms-100ms,multiplier=2,max=1m,jitter=0-1\", where the "+
+		"omitted ones are loose defaults. The _ExponentialBackoff tests fail if it's not set.")
+var scenarioDir = flag.String("scenario_dir", "testdata/scenarios",
+	"The directory of the JSON scenario files run by TestScenarios, see docs/test_case_writing.md.")
 
-// testProxyClient is the stub used by all the test cases to interact with the test proxy.
+// backoff is the parsed -backoff_profile.
+var backoff *backoffBounds
+
+// testProxyClient is the stub used by all the test cases to interact with the test proxy of the
+// current pass if there are several. Use proxyClient() in the helpers that may run against the
+// other proxies.
 var testProxyClient testproxypb.CloudBigtableV2TestProxyClient
 
 // TestMain is the entry point of test, where individual test cases are invoked.
 func TestMain(m *testing.M) {
 	// Parse and validate flags
 	flag.Parse()
-	if *proxyAddr == "" {
-		log.Fatal("Failed to set -proxy_addr, exiting now")
+	if *proxyAddr == "" && *proxyCmd == "" {
+		log.Fatal("Failed to set -proxy_addr or -proxy_cmd, exiting now")
+	}
+	if *recordDir != "" && *replayDir != "" {
+		log.Fatal("-record_dir and -replay_dir can't be set together, exiting now")
 	}
 
-	// Wait for the test proxy server if it's starting
-	retry := 0
-	for retry < 100 {
-		conn, err := net.Dial("tcp", *proxyAddr)
-		if err == nil && conn != nil {
-			conn.Close()
-			break
+	var err error
+	if backoff, err = parseBackoffProfile(*backoffProfile); err != nil {
+		log.Fatalf("Invalid -backoff_profile: %v", err)
+	}
+	if *proxyAddr != "" {
+		if proxies, err = parseProxyAddrs(*proxyAddr); err != nil {
+			log.Fatalf("Invalid -proxy_addr: %v", err)
+		}
+		if *proxyCmd != "" && len(proxies) > 1 {
+			log.Fatal("-proxy_cmd only launches one test proxy, but -proxy_addr has several, exiting now")
 		}
-		log.Println("Test Proxy is not ready, waiting...")
-		time.Sleep(time.Second)
-		retry++
 	}
-	if retry >= 100 {
-		log.Fatal("Test Proxy is not available, exiting now")
+
+	if *proxyCmd != "" {
+		// Start the test proxy
+		addr := ""
+		if len(proxies) == 1 {
+			addr = proxies[0].addr
+		}
+		launcher, err = newProxyLauncher(*proxyCmd, addr, *proxyLogDir)
+		if err != nil {
+			log.Fatalf("Failed to set up the test proxy: %v", err)
+		}
+		if err := launcher.start(); err != nil {
+			log.Fatalf("Failed to start the test proxy: %v", err)
+		}
+		proxies = []*testProxy{{name: launcher.addr, addr: launcher.addr}}
+	} else {
+		// Wait for the test proxy servers if they're starting
+		for _, p := range proxies {
+			if err := waitForProxy(p.addr, proxyReadyTimeout, nil); err != nil {
+				log.Fatalf("Test Proxy %s is not available, exiting now: %v", p.name, err)
+			}
+		}
 	}
 
-	// Create a test proxy client
-	conn, _ := grpc.Dial(*proxyAddr, grpc.WithInsecure())
-	defer conn.Close()
-	testProxyClient = testproxypb.NewCloudBigtableV2TestProxyClient(conn)
+	// Create the test proxy clients
+	for _, p := range proxies {
+		conn, _ := grpc.Dial(p.addr, grpc.WithInsecure())
+		defer conn.Close()
+		p.client = testproxypb.NewCloudBigtableV2TestProxyClient(conn)
+	}
 
-	// Invoke the test cases
-	exitVal := m.Run()
+	// Invoke the test cases, in a pass per test proxy
+	exitVal := 0
+	for i, p := range proxies {
+		passProxy, testProxyClient = i, p.client
+		if len(proxies) > 1 {
+			log.Printf("Running the tests against the test proxy %s", p.name)
+		}
+		if code := m.Run(); code != 0 {
+			exitVal = code
+		}
+	}
+	if launcher != nil {
+		launcher.stop()
+	}
 	os.Exit(exitVal)
 }
@@ -0,0 +1,78 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the access token checks of the mock server. The
+// "authorization" header of every request is recorded, and requests without
+// the expected token are rejected before they reach the mock functions.
+package tests
+
+import (
+	"context"
+	"path"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	gs "google.golang.org/grpc/status"
+)
+
+// authRecord is the "authorization" header of a request received by the mock server.
+type authRecord struct {
+	method        string
+	authorization string // "" means the header is absent.
+}
+
+// tokenChecker enforces the access token of the requests received by a mock server.
+type tokenChecker struct {
+	mu      sync.Mutex
+	token   string // "" means any request is accepted.
+	records []authRecord
+}
+
+// check records the "authorization" header in the incoming context `ctx` for `fullMethod`, and
+// returns an UNAUTHENTICATED error if it doesn't carry the expected token.
+func (c *tokenChecker) check(ctx context.Context, fullMethod string) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	var authorization string
+	if values := md.Get("authorization"); len(values) > 0 {
+		authorization = values[0]
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	method := path.Base(fullMethod)
+	c.records = append(c.records, authRecord{method: method, authorization: authorization})
+	if c.token != "" && authorization != "Bearer "+c.token {
+		serverLogger.Printf("%s request is rejected due to missing or invalid access token", method)
+		return gs.Error(codes.Unauthenticated, method+" failed: missing or invalid access token")
+	}
+	return nil
+}
+
+// unaryInterceptor checks the access token of unary RPCs.
+func (c *tokenChecker) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := c.check(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor checks the access token of streaming RPCs.
+func (c *tokenChecker) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := c.check(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
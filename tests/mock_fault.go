@@ -0,0 +1,227 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the fault injection layer of the mock server. Unlike the
+// "<operation>Action" types, which are consumed by the mock functions of
+// specific methods, fault rules are applied by the gRPC interceptors of the
+// server, so any RPC can get faults regardless of how it's served.
+package tests
+
+import (
+	"context"
+	"errors"
+	"log"
+	"path"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// faultRule declares a fault that the mock server injects into the matching requests.
+// Usage:
+//  1. faultRule{method: "ReadRows", rpcError: error}
+//     Effect: every ReadRows request will fail with the error, and the mock function won't be invoked.
+//  2. faultRule{method: "ReadRows", attempt: 1, rpcError: error}
+//     Effect: only the first ReadRows request will fail with the error.
+//  3. faultRule{method: "ReadRows", match: predicate, rpcError: error}
+//     Effect: the ReadRows requests that satisfy the predicate will fail with the error.
+//  4. faultRule{method: "ReadRows", afterMessages: n, rpcError: error}
+//     Effect: server will send n responses, and then fail the stream with the error. If the mock
+//     function sends n or fewer responses, the stream fails with the error once they're sent.
+//  5. faultRule{method: "ReadRows", afterMessages: n, truncate: true}
+//     Effect: server will send n responses, and then conclude the stream successfully.
+//  6. faultRule{method: "MutateRow", delayStr: delay}
+//     Effect: server will delay the request before serving it.
+//  7. faultRule{method: "MutateRow", rpcError: error, trailers: md, retryInfo: delay}
+//     Effect: server will return an error with the trailers and RetryInfo which has the specific delay.
+//
+// Responses sent by the mock function after the fault are dropped, and the status returned by the
+// mock function is replaced by the one of the fault. For unary methods, afterMessages and truncate
+// are ignored.
+type faultRule struct {
+	method        string                       // Method name, e.g., "ReadRows". "" means any method.
+	attempt       int                          // One-based index among the matching requests. 0 means every request.
+	match         func(req proto.Message) bool // nil means any request.
+	afterMessages int                          // The number of responses sent before the fault.
+	rpcError      codes.Code
+	truncate      bool   // If true, server will conclude the stream successfully at the fault.
+	delayStr      string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	trailers      metadata.MD
+	retryInfo     string // "" means no RetryInfo will be attached in the error status
+
+	seen int // The number of matching requests, including the current one. Guarded by faultInjector.mu.
+}
+
+func (r *faultRule) Validate() {
+	if r.rpcError != codes.OK && r.truncate {
+		log.Fatal("A fault rule cannot have both rpcError and truncate")
+	}
+	if r.afterMessages < 0 || r.attempt < 0 {
+		log.Fatal("afterMessages and attempt of a fault rule cannot be negative")
+	}
+}
+
+// errTruncated is used internally to drop the responses after a truncate fault.
+var errTruncated = errors.New("the stream is truncated by a fault rule")
+
+// faultInjector holds the fault rules of a mock server, and provides the interceptors that apply them.
+type faultInjector struct {
+	mu    sync.Mutex
+	rules []*faultRule
+}
+
+// addRules validates `rules`, and appends them to the existing ones.
+func (f *faultInjector) addRules(rules ...*faultRule) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, rule := range rules {
+		rule.Validate()
+		f.rules = append(f.rules, rule)
+	}
+}
+
+// selectRule returns the first rule that applies to the request `req` of `fullMethod`, or nil if
+// there is none. The attempts of all the matching rules are counted in the process.
+func (f *faultInjector) selectRule(fullMethod string, req proto.Message) *faultRule {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	method := path.Base(fullMethod)
+	var selected *faultRule
+	for _, rule := range f.rules {
+		if rule.method != "" && rule.method != method {
+			continue
+		}
+		if rule.match != nil && !rule.match(req) {
+			continue
+		}
+		rule.seen++
+		if selected == nil && (rule.attempt == 0 || rule.attempt == rule.seen) {
+			selected = rule
+		}
+	}
+	if selected != nil {
+		serverLogger.Printf("Fault rule is applied to %s request (attempt %d)", method, selected.seen)
+	}
+	return selected
+}
+
+// seen returns the number of requests that have matched `rule` so far.
+func (f *faultInjector) seen(rule *faultRule) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return rule.seen
+}
+
+// apply performs the fault of `rule` on a request of `fullMethod`, and returns the resultant error.
+// For a truncate fault, errTruncated is returned.
+func (r *faultRule) apply(fullMethod string, setTrailer func(metadata.MD)) error {
+	sleepFor(r.delayStr)
+	if len(r.trailers) > 0 {
+		setTrailer(r.trailers)
+	}
+	if r.rpcError != codes.OK {
+		return buildActionError(path.Base(fullMethod), r.rpcError, r.retryInfo)
+	}
+	if r.truncate {
+		return errTruncated
+	}
+	return nil
+}
+
+// unaryInterceptor applies the fault rules to unary RPCs.
+func (f *faultInjector) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	msg, _ := req.(proto.Message)
+	rule := f.selectRule(info.FullMethod, msg)
+	if rule == nil {
+		return handler(ctx, req)
+	}
+
+	err := rule.apply(info.FullMethod, func(md metadata.MD) { grpc.SetTrailer(ctx, md) })
+	if err != nil && err != errTruncated {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor applies the fault rules to streaming RPCs.
+func (f *faultInjector) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	fs := &faultStream{ServerStream: ss, injector: f, fullMethod: info.FullMethod}
+	err := handler(srv, fs)
+	if fs.rule != nil && fs.rule.afterMessages > 0 && fs.faultErr == nil {
+		// The stream ended before the position of the fault, which is then applied at the end, so
+		// that the test doesn't see a clean stream.
+		serverLogger.Printf("%s stream ends after %d of the %d responses before the fault", path.Base(info.FullMethod),
+			fs.sent, fs.rule.afterMessages)
+		fs.faultErr = fs.rule.apply(info.FullMethod, fs.SetTrailer)
+	}
+	if fs.faultErr == errTruncated {
+		return nil
+	}
+	if fs.faultErr != nil {
+		return fs.faultErr
+	}
+	return err
+}
+
+// faultStream wraps a server stream, so that the fault rule can be selected on receiving the
+// request and be applied at the right position of the response stream.
+type faultStream struct {
+	grpc.ServerStream
+	injector   *faultInjector
+	fullMethod string
+
+	received bool
+	rule     *faultRule
+	sent     int
+	faultErr error // Non-nil once the fault has been applied.
+}
+
+func (s *faultStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.received {
+		return nil
+	}
+	s.received = true
+
+	msg, _ := m.(proto.Message)
+	s.rule = s.injector.selectRule(s.fullMethod, msg)
+	if s.rule != nil && s.rule.afterMessages == 0 {
+		s.faultErr = s.rule.apply(s.fullMethod, s.SetTrailer)
+		if s.faultErr != nil && s.faultErr != errTruncated {
+			// Fail the request before the mock function is invoked.
+			return s.faultErr
+		}
+	}
+	return nil
+}
+
+func (s *faultStream) SendMsg(m any) error {
+	if s.faultErr != nil {
+		return s.faultErr
+	}
+	if s.rule != nil && s.rule.afterMessages > 0 && s.sent == s.rule.afterMessages {
+		s.faultErr = s.rule.apply(s.fullMethod, s.SetTrailer)
+		if s.faultErr != nil {
+			return s.faultErr
+		}
+	}
+	s.sent++
+	return s.ServerStream.SendMsg(m)
+}
@@ -17,18 +17,23 @@ package tests
 import (
 	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"regexp"
 	"time"
 
+	adminpb "cloud.google.com/go/bigtable/admin/apiv2/adminpb"
 	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	gs "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	drpb "google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
 	wrappers "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -49,6 +54,33 @@ func sleepFor(duration string) {
 	}
 }
 
+// setStreamMetadata sets the response `header` and `trailer` of an action on the stream `srv`. The
+// header is only sent if no response has been sent on the stream yet, e.g., by an earlier action.
+func setStreamMetadata(srv grpc.ServerStream, header metadata.MD, trailer metadata.MD) {
+	if len(header) > 0 {
+		if err := srv.SetHeader(header); err != nil {
+			serverLogger.Printf("Failed to set the response header %v: %v", header, err)
+		}
+	}
+	if len(trailer) > 0 {
+		srv.SetTrailer(trailer)
+	}
+}
+
+// setUnaryMetadata sets the response `header` and `trailer` of an action on the unary call of `ctx`.
+func setUnaryMetadata(ctx context.Context, header metadata.MD, trailer metadata.MD) {
+	if len(header) > 0 {
+		if err := grpc.SetHeader(ctx, header); err != nil {
+			serverLogger.Printf("Failed to set the response header %v: %v", header, err)
+		}
+	}
+	if len(trailer) > 0 {
+		if err := grpc.SetTrailer(ctx, trailer); err != nil {
+			serverLogger.Printf("Failed to set the response trailer %v: %v", trailer, err)
+		}
+	}
+}
+
 // expandDim inserts a length 1 dimension to the input array, and returns the resultant 2-D array.
 // Input: [a1_for_req1, a2_for_req2, ..., aN_for_reqN] -- There is one action per request.
 // Output: [[a1_for_req1], [a2_for_req2], ..., [aN_for_reqN]]
@@ -116,6 +148,37 @@ func retrieveActions[A anyAction](opIDToActionQueue map[string]chan A, rowKey []
 	return actionQueue, nil
 }
 
+// retrieveStoreActions is the variant of retrieveActions for the store-backed mock functions,
+// where the action queue is optional. nil queue is returned if there are no actions at all.
+func retrieveStoreActions[A anyAction](opIDToActionQueue map[string]chan A, rowKey []byte) (chan A, error) {
+	if len(opIDToActionQueue) == 0 {
+		return nil, nil
+	}
+	return retrieveActions(opIDToActionQueue, rowKey)
+}
+
+// nextStoreAction returns the next action in `actionQueue` for the store-backed mock functions.
+// false is returned if the actions are used up, so that the request is served from the store.
+func nextStoreAction[A anyAction](actionQueue chan A) (A, bool) {
+	if actionQueue == nil {
+		var none A
+		return none, false
+	}
+	action, more := <-actionQueue
+	return action, more
+}
+
+// buildActionError returns the error of `method` for an action with non-OK `code`. Non-empty
+// `retryInfo` will attach RetryInfo with the specified delay to the error status.
+func buildActionError(method string, code codes.Code, retryInfo string) error {
+	st := gs.New(code, method+" failed")
+	if retryInfo != "" {
+		delay, _ := time.ParseDuration(retryInfo)
+		st, _ = st.WithDetails(&errdetails.RetryInfo{RetryDelay: drpb.New(delay)})
+	}
+	return st.Err()
+}
+
 // mockReadRowsFnSimple is a simple wrapper of mockReadRowsFn. It's useful when server only performs
 // one action per request, as users don't need to assemble an array of actions per request.
 func mockReadRowsFnSimple(recorder chan<- *readRowsReqRecord, actions ...*readRowsAction) func(*btpb.ReadRowsRequest, btpb.Bigtable_ReadRowsServer) error {
@@ -123,16 +186,12 @@ func mockReadRowsFnSimple(recorder chan<- *readRowsReqRecord, actions ...*readRo
 	return mockReadRowsFn(recorder, actionSequences...)
 }
 
-func mockReadRowsFn(recorder chan<- *readRowsReqRecord, actionSequences ...[]*readRowsAction) func(*btpb.ReadRowsRequest, btpb.Bigtable_ReadRowsServer) error {
-	return mockReadRowsFnWithMetadata(recorder, nil, actionSequences...)
-}
-
 // mockReadRowsFn returns a mock implementation of server-side ReadRows(). The behavior is
 // customized by `actionSequences`. Non-nil `recorder` will be used to log the requests
 // (including retries) received by the server in time order, up to its capacity.
 // For concurrency testing, each request MUST have prefix "opX-" in the row key(s), indicating
 // that the X-th (zero based) actionSequence will be used to serve the request.
-func mockReadRowsFnWithMetadata(recorder chan<- *readRowsReqRecord, mdRecorder chan metadata.MD, actionSequences ...[]*readRowsAction) func(*btpb.ReadRowsRequest, btpb.Bigtable_ReadRowsServer) error {
+func mockReadRowsFn(recorder chan<- *readRowsReqRecord, actionSequences ...[]*readRowsAction) func(*btpb.ReadRowsRequest, btpb.Bigtable_ReadRowsServer) error {
 	// Build the map so that server can retrieve the proper action queue by key "opX-".
 	opIDToActionQueue := make(map[string]chan *readRowsAction)
 	buildActionMap(opIDToActionQueue, actionSequences)
@@ -142,12 +201,6 @@ func mockReadRowsFnWithMetadata(recorder chan<- *readRowsReqRecord, mdRecorder c
 			serverLogger.Printf("Request from client: %+v", req)
 		}
 
-		// Record the metadata
-		if mdRecorder != nil {
-			md, _ := metadata.FromIncomingContext(srv.Context())
-			mdRecorder <- md
-		}
-
 		// Record the request
 		reqRecord := &readRowsReqRecord{
 			req: req,
@@ -175,6 +228,7 @@ func mockReadRowsFnWithMetadata(recorder chan<- *readRowsReqRecord, mdRecorder c
 				break
 			}
 			sleepFor(action.delayStr)
+			setStreamMetadata(srv, action.header, action.trailer)
 
 			if action.rpcError != codes.OK {
 				if action.routingCookie != "" {
@@ -219,6 +273,10 @@ func mockReadRowsFnWithMetadata(recorder chan<- *readRowsReqRecord, mdRecorder c
 				}
 				res.Chunks = append(res.Chunks, cellChunk)
 			}
+			if len(action.rawChunks) > 0 {
+				lastRowKey = []byte{}
+				res.Chunks = append(res.Chunks, action.rawChunks...)
+			}
 
 			if len(res.Chunks) > 0 {
 				srv.Send(res)
@@ -236,16 +294,105 @@ func mockReadRowsFnWithMetadata(recorder chan<- *readRowsReqRecord, mdRecorder c
 	}
 }
 
+// mockReadRowsFnWithStore returns a mock implementation of server-side ReadRows(), which serves
+// the rows from `store`. `actionSequences` can inject errors and delays on top of the stored data:
+// a readRowsAction with non-OK rpcError fails the attempt, and a readRowsAction without error
+// serves the next `numRows` rows (all the remaining rows if zero) of the attempt from the store.
+// Chunks specified in the actions are ignored. Once the actions are used up, requests are served
+// from the store directly. Non-nil `recorder` and the concurrency testing work the same way as
+// mockReadRowsFn().
+func mockReadRowsFnWithStore(store *tableStore, recorder chan<- *readRowsReqRecord, actionSequences ...[]*readRowsAction) func(*btpb.ReadRowsRequest, btpb.Bigtable_ReadRowsServer) error {
+	// Build the map so that server can retrieve the proper action queue by key "opX-".
+	opIDToActionQueue := make(map[string]chan *readRowsAction)
+	buildActionMap(opIDToActionQueue, actionSequences)
+
+	return func(req *btpb.ReadRowsRequest, srv btpb.Bigtable_ReadRowsServer) error {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &readRowsReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Select the actions to perform
+		var rowKey []byte
+		if req.GetRows() != nil && len(req.GetRows().GetRowKeys()) > 0 {
+			rowKey = req.GetRows().GetRowKeys()[0]
+		} else if len(opIDToActionQueue) > 1 {
+			return gs.Error(codes.InvalidArgument, "The ReadRows request must contain rowkeys for concurrency testing")
+		}
+
+		actionQueue, err := retrieveStoreActions(opIDToActionQueue, rowKey)
+		if err != nil {
+			return err
+		}
+
+		rows, err := store.readRows(srv.Context(), req)
+		if err != nil {
+			return err
+		}
+		defer rows.close()
+
+		// Perform the actions
+		for {
+			action, more := nextStoreAction(actionQueue)
+			if !more {
+				_, err := sendRowsFromStore(srv, rows, 0)
+				return err
+			}
+			sleepFor(action.delayStr)
+			setStreamMetadata(srv, action.header, action.trailer)
+
+			if action.rpcError != codes.OK {
+				if action.routingCookie != "" {
+					// add routing cookie to metadata
+					trailer := metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie)
+					srv.SetTrailer(trailer)
+				}
+				return buildActionError("ReadRows", action.rpcError, action.retryInfo)
+			}
+
+			done, err := sendRowsFromStore(srv, rows, action.numRows)
+			if err != nil || done || action.numRows == 0 {
+				return err
+			}
+		}
+	}
+}
+
+// sendRowsFromStore sends up to `numRows` rows (all the remaining rows if zero) of `rows` to the
+// client. Responses that only carry the last scanned row key are not counted as rows. true is
+// returned if there are no more rows to send.
+func sendRowsFromStore(srv btpb.Bigtable_ReadRowsServer, rows *readRowsStream, numRows int) (bool, error) {
+	for sent := 0; numRows == 0 || sent < numRows; {
+		res, err := rows.next()
+		if err == io.EOF {
+			return true, nil
+		}
+		if err != nil {
+			return true, err
+		}
+		if err := srv.Send(res); err != nil {
+			return true, err
+		}
+		if len(res.GetChunks()) > 0 {
+			sent++
+		}
+	}
+	return false, nil
+}
+
 // mockSampleRowKeysFn returns a mock implementation of server-side SampleRowKeys().
 // The behavior is customized by `actions`. Non-nil `recorder` will be used to log the requests
 // (including retries) received by the server in time order, up to its allocated capacity.
-// Unlike the other methods, the concurrency testing is quite restricted as we cannot differentiate
-// the requests by row keys (only table name is specified in the request).
+// Unlike the other methods, the requests cannot be differentiated by row keys (only table name is
+// specified in the request). For concurrency testing, route the requests to independent mock
+// functions by table name or app profile via routeStreamFn().
 func mockSampleRowKeysFn(recorder chan<- *sampleRowKeysReqRecord, actions []sampleRowKeysAction) func(*btpb.SampleRowKeysRequest, btpb.Bigtable_SampleRowKeysServer) error {
-	return mockSampleRowKeysFnWithMetadata(recorder, nil, actions)
-}
-
-func mockSampleRowKeysFnWithMetadata(recorder chan<- *sampleRowKeysReqRecord, mdRecorder chan metadata.MD, actions []sampleRowKeysAction) func(*btpb.SampleRowKeysRequest, btpb.Bigtable_SampleRowKeysServer) error {
 	// Enqueue the actions, and server will consume the queue via FIFO.
 	actionQueue := make(chan *sampleRowKeysAction, len(actions))
 	for i := range actions {
@@ -258,12 +405,6 @@ func mockSampleRowKeysFnWithMetadata(recorder chan<- *sampleRowKeysReqRecord, md
 			serverLogger.Printf("Request from client: %+v", req)
 		}
 
-		// Record the metadata
-		if mdRecorder != nil {
-			md, _ := metadata.FromIncomingContext(srv.Context())
-			mdRecorder <- md
-		}
-
 		// Record the request
 		reqRecord := &sampleRowKeysReqRecord{
 			req: req,
@@ -277,6 +418,7 @@ func mockSampleRowKeysFnWithMetadata(recorder chan<- *sampleRowKeysReqRecord, md
 				break
 			}
 			sleepFor(action.delayStr)
+			setStreamMetadata(srv, action.header, action.trailer)
 
 			if action.rpcError != codes.OK {
 				if action.routingCookie != "" {
@@ -310,6 +452,60 @@ func mockSampleRowKeysFnWithMetadata(recorder chan<- *sampleRowKeysReqRecord, md
 	}
 }
 
+// mockSampleRowKeysFnWithStore returns a mock implementation of server-side SampleRowKeys(),
+// which serves the sampled row keys from `store`. `actions` can inject errors and delays on top of
+// the stored data: a sampleRowKeysAction with non-OK rpcError fails the attempt, and a
+// sampleRowKeysAction without error serves all the samples. rowKey and offsetBytes specified in
+// the actions are ignored. Once the actions are used up, requests are served from the store
+// directly. Non-nil `recorder` works the same way as mockSampleRowKeysFn().
+func mockSampleRowKeysFnWithStore(store *tableStore, recorder chan<- *sampleRowKeysReqRecord, actions []sampleRowKeysAction) func(*btpb.SampleRowKeysRequest, btpb.Bigtable_SampleRowKeysServer) error {
+	// Enqueue the actions, and server will consume the queue via FIFO.
+	actionQueue := make(chan *sampleRowKeysAction, len(actions))
+	for i := range actions {
+		actionQueue <- &actions[i]
+	}
+	close(actionQueue)
+
+	return func(req *btpb.SampleRowKeysRequest, srv btpb.Bigtable_SampleRowKeysServer) error {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &sampleRowKeysReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Perform the action
+		if action, more := <-actionQueue; more {
+			sleepFor(action.delayStr)
+			setStreamMetadata(srv, action.header, action.trailer)
+
+			if action.rpcError != codes.OK {
+				if action.routingCookie != "" {
+					// add routing cookie to metadata
+					trailer := metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie)
+					srv.SetTrailer(trailer)
+				}
+				return buildActionError("SampleRowKeys", action.rpcError, action.retryInfo)
+			}
+		}
+
+		samples, err := store.sampleRowKeys(srv.Context(), req)
+		if err != nil {
+			return err
+		}
+		for _, res := range samples {
+			if err := srv.Send(res); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
 // mockMutateRowFnSimple is a simple wrapper of mockMutateRowFn. It's useful when server only
 // performs one action per request, as users don't need to assemble an array of actions per request.
 func mockMutateRowFnSimple(recorder chan<- *mutateRowReqRecord, actions ...*mutateRowAction) func(context.Context, *btpb.MutateRowRequest) (*btpb.MutateRowResponse, error) {
@@ -349,15 +545,66 @@ func mockMutateRowFn(recorder chan<- *mutateRowReqRecord, actionSequences ...[]*
 		// Perform the actions
 		action := <-actionQueue
 		sleepFor(action.delayStr)
+		setUnaryMetadata(ctx, action.header, action.trailer)
 
 		if action.rpcError != codes.OK {
-			return nil, gs.Error(action.rpcError, "MutateRow failed")
+			if action.routingCookie != "" {
+				// add routing cookie to metadata
+				setUnaryMetadata(ctx, nil, metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie))
+			}
+			return nil, buildActionError("MutateRow", action.rpcError, action.retryInfo)
 		}
 
 		return &btpb.MutateRowResponse{}, nil
 	}
 }
 
+// mockMutateRowFnWithStore returns a mock implementation of server-side MutateRow(), which applies
+// the mutations to `store`. `actionSequences` can inject errors and delays on top of the stored
+// data: a mutateRowAction with non-OK rpcError fails the attempt without mutating the row. Once
+// the actions are used up, requests are served from the store directly. Non-nil `recorder` and the
+// concurrency testing work the same way as mockMutateRowFn().
+func mockMutateRowFnWithStore(store *tableStore, recorder chan<- *mutateRowReqRecord, actionSequences ...[]*mutateRowAction) func(context.Context, *btpb.MutateRowRequest) (*btpb.MutateRowResponse, error) {
+	// Build the map so that server can retrieve the proper action queue by key "opX-".
+	opIDToActionQueue := make(map[string]chan *mutateRowAction)
+	buildActionMap(opIDToActionQueue, actionSequences)
+
+	return func(ctx context.Context, req *btpb.MutateRowRequest) (*btpb.MutateRowResponse, error) {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &mutateRowReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Select the actions to perform
+		actionQueue, err := retrieveStoreActions(opIDToActionQueue, req.GetRowKey())
+		if err != nil {
+			return nil, err
+		}
+
+		// Perform the action
+		if action, more := nextStoreAction(actionQueue); more {
+			sleepFor(action.delayStr)
+			setUnaryMetadata(ctx, action.header, action.trailer)
+
+			if action.rpcError != codes.OK {
+				if action.routingCookie != "" {
+					// add routing cookie to metadata
+					setUnaryMetadata(ctx, nil, metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie))
+				}
+				return nil, buildActionError("MutateRow", action.rpcError, action.retryInfo)
+			}
+		}
+
+		return store.mutateRow(ctx, req)
+	}
+}
+
 // mockMutateRowsFnSimple is a simple wrapper of mockMutateRowsFn. It's useful when server only
 // performs one action per request, as users don't need to assemble an array of actions per request.
 func mockMutateRowsFnSimple(recorder chan<- *mutateRowsReqRecord, actions ...*mutateRowsAction) func(*btpb.MutateRowsRequest, btpb.Bigtable_MutateRowsServer) error {
@@ -371,10 +618,6 @@ func mockMutateRowsFnSimple(recorder chan<- *mutateRowsReqRecord, actions ...*mu
 // For concurrency testing, each request MUST have prefix "opX-" in the row keys, indicating
 // that the X-th (zero based) actionSequence will be used to serve the request.
 func mockMutateRowsFn(recorder chan<- *mutateRowsReqRecord, actionSequences ...[]*mutateRowsAction) func(*btpb.MutateRowsRequest, btpb.Bigtable_MutateRowsServer) error {
-	return mockMutateRowsFnWithMetadata(recorder, nil, actionSequences...)
-}
-
-func mockMutateRowsFnWithMetadata(recorder chan<- *mutateRowsReqRecord, mdRecorder chan metadata.MD, actionSequences ...[]*mutateRowsAction) func(*btpb.MutateRowsRequest, btpb.Bigtable_MutateRowsServer) error {
 	// Build the map so that server can retrieve the proper action queue by key "opX-".
 	opIDToActionQueue := make(map[string]chan *mutateRowsAction)
 	buildActionMap(opIDToActionQueue, actionSequences)
@@ -384,12 +627,6 @@ func mockMutateRowsFnWithMetadata(recorder chan<- *mutateRowsReqRecord, mdRecord
 			serverLogger.Printf("Request from client: %+v", req)
 		}
 
-		// Record the metadata
-		if mdRecorder != nil {
-			md, _ := metadata.FromIncomingContext(srv.Context())
-			mdRecorder <- md
-		}
-
 		// Record the request
 		reqRecord := &mutateRowsReqRecord{
 			req: req,
@@ -417,6 +654,7 @@ func mockMutateRowsFnWithMetadata(recorder chan<- *mutateRowsReqRecord, mdRecord
 				break
 			}
 			sleepFor(action.delayStr)
+			setStreamMetadata(srv, action.header, action.trailer)
 
 			if action.rpcError != codes.OK {
 				if action.routingCookie != "" {
@@ -436,7 +674,7 @@ func mockMutateRowsFnWithMetadata(recorder chan<- *mutateRowsReqRecord, mdRecord
 				return gs.Error(action.rpcError, "MutateRows failed")
 			}
 
-			res := &btpb.MutateRowsResponse{}
+			res := &btpb.MutateRowsResponse{RateLimitInfo: action.rateLimitInfo}
 			// Fill in entries for rows mutated successfully
 			for _, idx := range action.data.mutatedRows {
 				res.Entries = append(res.Entries, &btpb.MutateRowsResponse_Entry{
@@ -463,6 +701,121 @@ func mockMutateRowsFnWithMetadata(recorder chan<- *mutateRowsReqRecord, mdRecord
 	}
 }
 
+// mockMutateRowsFnWithStore returns a mock implementation of server-side MutateRows(), which
+// applies the mutations to `store`. `actionSequences` can inject errors and delays on top of the
+// stored data: a mutateRowsAction with non-OK rpcError fails the attempt without mutating any
+// row, and a mutateRowsAction without error applies all the entries except for those in
+// `data.failedRows`, which fail with the given error codes. `data.mutatedRows` is ignored, and
+// the stream concludes after the action. Once the actions are used up, requests are served from
+// the store directly. Non-nil `recorder` and the concurrency testing work the same way as
+// mockMutateRowsFn().
+func mockMutateRowsFnWithStore(store *tableStore, recorder chan<- *mutateRowsReqRecord, actionSequences ...[]*mutateRowsAction) func(*btpb.MutateRowsRequest, btpb.Bigtable_MutateRowsServer) error {
+	// Build the map so that server can retrieve the proper action queue by key "opX-".
+	opIDToActionQueue := make(map[string]chan *mutateRowsAction)
+	buildActionMap(opIDToActionQueue, actionSequences)
+
+	return func(req *btpb.MutateRowsRequest, srv btpb.Bigtable_MutateRowsServer) error {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &mutateRowsReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Select the actions to perform
+		var rowKey []byte
+		if len(req.GetEntries()) > 0 {
+			rowKey = req.GetEntries()[0].GetRowKey()
+		} else if len(opIDToActionQueue) > 1 {
+			return gs.Error(codes.InvalidArgument, "The MutateRows request must contain entries for concurrency testing")
+		}
+
+		actionQueue, err := retrieveStoreActions(opIDToActionQueue, rowKey)
+		if err != nil {
+			return err
+		}
+
+		// Perform the action
+		failedRows := make(map[int]codes.Code)
+		var rateLimitInfo *btpb.RateLimitInfo
+		if action, more := nextStoreAction(actionQueue); more {
+			sleepFor(action.delayStr)
+			setStreamMetadata(srv, action.header, action.trailer)
+
+			if action.rpcError != codes.OK {
+				if action.routingCookie != "" {
+					// add routing cookie to metadata
+					trailer := metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie)
+					srv.SetTrailer(trailer)
+				}
+				return buildActionError("MutateRows", action.rpcError, action.retryInfo)
+			}
+			for errorCode, idxs := range action.data.failedRows {
+				for _, idx := range idxs {
+					failedRows[idx] = errorCode
+				}
+			}
+			rateLimitInfo = action.rateLimitInfo
+		}
+
+		// Apply the entries that don't fail, and map their indices back to the request.
+		storeReq := proto.Clone(req).(*btpb.MutateRowsRequest)
+		storeReq.Entries = nil
+		var storeIdxs []int
+		for idx, entry := range req.GetEntries() {
+			if _, failed := failedRows[idx]; !failed {
+				storeReq.Entries = append(storeReq.Entries, entry)
+				storeIdxs = append(storeIdxs, idx)
+			}
+		}
+
+		res := &btpb.MutateRowsResponse{RateLimitInfo: rateLimitInfo}
+		if len(storeReq.Entries) > 0 {
+			entries, err := store.mutateRows(srv.Context(), storeReq)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				entry.Index = int64(storeIdxs[entry.Index])
+				res.Entries = append(res.Entries, entry)
+			}
+		}
+		for idx, errorCode := range failedRows {
+			res.Entries = append(res.Entries, &btpb.MutateRowsResponse_Entry{
+				Index:  int64(idx),
+				Status: &status.Status{Code: int32(errorCode)},
+			})
+		}
+		return srv.Send(res)
+	}
+}
+
+// mockMutateRowsFnWithRateLimit returns a mock implementation of server-side MutateRows() for
+// long-running workloads, which mutates all the entries successfully and attaches the
+// RateLimitInfo returned by `rateLimit` at the time of the request, where nil means none. Non-nil
+// `recorder` will be used to log the requests like mockMutateRowsFn().
+func mockMutateRowsFnWithRateLimit(recorder chan<- *mutateRowsReqRecord, rateLimit func() *btpb.RateLimitInfo) func(*btpb.MutateRowsRequest, btpb.Bigtable_MutateRowsServer) error {
+	return func(req *btpb.MutateRowsRequest, srv btpb.Bigtable_MutateRowsServer) error {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+		saveReqRecord(recorder, &mutateRowsReqRecord{req: req, ts: time.Now()})
+
+		res := &btpb.MutateRowsResponse{RateLimitInfo: rateLimit()}
+		for idx := range req.GetEntries() {
+			res.Entries = append(res.Entries, &btpb.MutateRowsResponse_Entry{
+				Index:  int64(idx),
+				Status: &status.Status{},
+			})
+		}
+		return srv.Send(res)
+	}
+}
+
 // mockCheckAndMutateRowFnSimple is a simple wrapper of mockCheckAndMutateRowFn. It's useful when
 // server only performs one action per request, as users don't need to assemble an array of actions
 // per request.
@@ -503,15 +856,68 @@ func mockCheckAndMutateRowFn(recorder chan<- *checkAndMutateRowReqRecord, action
 		// Perform the action
 		action := <-actionQueue
 		sleepFor(action.delayStr)
+		setUnaryMetadata(ctx, action.header, action.trailer)
 
 		if action.rpcError != codes.OK {
-			return nil, gs.Error(action.rpcError, "CheckAndMutateRow failed")
+			if action.routingCookie != "" {
+				// add routing cookie to metadata
+				setUnaryMetadata(ctx, nil, metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie))
+			}
+			return nil, buildActionError("CheckAndMutateRow", action.rpcError, action.retryInfo)
 		}
 
 		return &btpb.CheckAndMutateRowResponse{PredicateMatched: action.predicateMatched}, nil
 	}
 }
 
+// mockCheckAndMutateRowFnWithStore returns a mock implementation of server-side
+// CheckAndMutateRow(), which checks the predicate and applies the mutations on `store`.
+// `actionSequences` can inject errors and delays on top of the stored data: a
+// checkAndMutateRowAction with non-OK rpcError fails the attempt without mutating the row.
+// predicateMatched specified in the actions is ignored. Once the actions are used up, requests are
+// served from the store directly. Non-nil `recorder` and the concurrency testing work the same way
+// as mockCheckAndMutateRowFn().
+func mockCheckAndMutateRowFnWithStore(store *tableStore, recorder chan<- *checkAndMutateRowReqRecord, actionSequences ...[]*checkAndMutateRowAction) func(context.Context, *btpb.CheckAndMutateRowRequest) (*btpb.CheckAndMutateRowResponse, error) {
+	// Build the map so that server can retrieve the proper action queue by key "opX-".
+	opIDToActionQueue := make(map[string]chan *checkAndMutateRowAction)
+	buildActionMap(opIDToActionQueue, actionSequences)
+
+	return func(ctx context.Context, req *btpb.CheckAndMutateRowRequest) (*btpb.CheckAndMutateRowResponse, error) {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &checkAndMutateRowReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Select the actions to perform
+		actionQueue, err := retrieveStoreActions(opIDToActionQueue, req.GetRowKey())
+		if err != nil {
+			return nil, err
+		}
+
+		// Perform the action
+		if action, more := nextStoreAction(actionQueue); more {
+			sleepFor(action.delayStr)
+			setUnaryMetadata(ctx, action.header, action.trailer)
+
+			if action.rpcError != codes.OK {
+				if action.routingCookie != "" {
+					// add routing cookie to metadata
+					setUnaryMetadata(ctx, nil, metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie))
+				}
+				return nil, buildActionError("CheckAndMutateRow", action.rpcError, action.retryInfo)
+			}
+		}
+
+		return store.checkAndMutateRow(ctx, req)
+	}
+}
+
 // mockReadModifyWriteRowFnSimple is a simple wrapper of mockReadModifyWriteRowFn. It's useful when
 // server only performs one action per request, as users don't need to assemble an array of actions
 // per request.
@@ -551,33 +957,79 @@ func mockReadModifyWriteRowFn(recorder chan<- *readModifyWriteRowReqRecord, acti
 		// Perform the action
 		action := <-actionQueue
 		sleepFor(action.delayStr)
+		setUnaryMetadata(ctx, action.header, action.trailer)
 		if action.rpcError != codes.OK {
-			return nil, gs.Error(action.rpcError, "ReadModifyWriteRow failed")
+			if action.routingCookie != "" {
+				// add routing cookie to metadata
+				setUnaryMetadata(ctx, nil, metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie))
+			}
+			return nil, buildActionError("ReadModifyWriteRow", action.rpcError, action.retryInfo)
 		}
 
 		return &btpb.ReadModifyWriteRowResponse{Row: action.row}, nil
 	}
 }
 
-// mockExecuteQueryFnSimple is a simple wrapper of mockExecuteQueryFn. It's useful when server only performs
-// one action per request, as users don't need to assemble an array of actions per request.
-func mockExecuteQueryFn(recorder chan<- *executeQueryReqRecord, actionSequence ...*executeQueryAction) func(*btpb.ExecuteQueryRequest, btpb.Bigtable_ExecuteQueryServer) error {
-	return mockExecuteQueryFnWithMetadataSimple(recorder, nil, actionSequence...)
+// mockReadModifyWriteRowFnWithStore returns a mock implementation of server-side
+// ReadModifyWriteRow(), which applies the rules on `store` and returns the updated cells.
+// `actionSequences` can inject errors and delays on top of the stored data: a
+// readModifyWriteRowAction with non-OK rpcError fails the attempt without modifying the row. row
+// specified in the actions is ignored. Once the actions are used up, requests are served from the
+// store directly. Non-nil `recorder` and the concurrency testing work the same way as
+// mockReadModifyWriteRowFn().
+func mockReadModifyWriteRowFnWithStore(store *tableStore, recorder chan<- *readModifyWriteRowReqRecord, actionSequences ...[]*readModifyWriteRowAction) func(context.Context, *btpb.ReadModifyWriteRowRequest) (*btpb.ReadModifyWriteRowResponse, error) {
+	opIDToActionQueue := make(map[string]chan *readModifyWriteRowAction)
+	buildActionMap(opIDToActionQueue, actionSequences)
+
+	return func(ctx context.Context, req *btpb.ReadModifyWriteRowRequest) (*btpb.ReadModifyWriteRowResponse, error) {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &readModifyWriteRowReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Select the actions to perform
+		actionQueue, err := retrieveStoreActions(opIDToActionQueue, req.GetRowKey())
+		if err != nil {
+			return nil, err
+		}
+
+		// Perform the action
+		if action, more := nextStoreAction(actionQueue); more {
+			sleepFor(action.delayStr)
+			setUnaryMetadata(ctx, action.header, action.trailer)
+			if action.rpcError != codes.OK {
+				if action.routingCookie != "" {
+					// add routing cookie to metadata
+					setUnaryMetadata(ctx, nil, metadata.Pairs("x-goog-cbt-cookie-test", action.routingCookie))
+				}
+				return nil, buildActionError("ReadModifyWriteRow", action.rpcError, action.retryInfo)
+			}
+		}
+
+		return store.readModifyWriteRow(ctx, req)
+	}
 }
 
-// helper for using mockExecuteQueryFnWithMetadata with a single action sequence
-func mockExecuteQueryFnWithMetadataSimple(recorder chan<- *executeQueryReqRecord, mdRecorder chan metadata.MD, actionSequence ...*executeQueryAction) func(*btpb.ExecuteQueryRequest, btpb.Bigtable_ExecuteQueryServer) error {
+// mockExecuteQueryFn is a simple wrapper of mockExecuteQueryFnWithSequences. It's useful when server only performs
+// one action per request, as users don't need to assemble an array of actions per request.
+func mockExecuteQueryFn(recorder chan<- *executeQueryReqRecord, actionSequence ...*executeQueryAction) func(*btpb.ExecuteQueryRequest, btpb.Bigtable_ExecuteQueryServer) error {
 	actionSequences := make(map[string][]*executeQueryAction, 1)
 	actionSequences["onlySequence"] = actionSequence
-	return mockExecuteQueryFnWithMetadata(recorder, mdRecorder, actionSequences)
+	return mockExecuteQueryFnWithSequences(recorder, actionSequences)
 }
 
-// mockExecuteQueryFn returns a mock implementation of server-side ExecuteQuery(). The behavior is
+// mockExecuteQueryFnWithSequences returns a mock implementation of server-side ExecuteQuery(). The behavior is
 // customized by `actionSequences`. Non-nil `recorder` will be used to log the requests
 // (including retries) received by the server in time order, up to its capacity.
 // If only one actionSequence is specified then it is used for all requests. If multiple are
 // specified this expects the Request PreparedQuery to be the map key of the sequence it should use.
-func mockExecuteQueryFnWithMetadata(recorder chan<- *executeQueryReqRecord, mdRecorder chan metadata.MD, actionSequences map[string][]*executeQueryAction) func(*btpb.ExecuteQueryRequest, btpb.Bigtable_ExecuteQueryServer) error {
+func mockExecuteQueryFnWithSequences(recorder chan<- *executeQueryReqRecord, actionSequences map[string][]*executeQueryAction) func(*btpb.ExecuteQueryRequest, btpb.Bigtable_ExecuteQueryServer) error {
 	preparedQueryToActionQueue := make(map[string]chan *executeQueryAction)
 	for preparedQuery, actionSequence := range actionSequences {
 		// Convert the action sequence to a queue for server to consume.
@@ -604,12 +1056,6 @@ func mockExecuteQueryFnWithMetadata(recorder chan<- *executeQueryReqRecord, mdRe
 			selectedActionQueue = preparedQueryToActionQueue[string(req.PreparedQuery)]
 		}
 
-		// Record the metadata
-		if mdRecorder != nil {
-			md, _ := metadata.FromIncomingContext(srv.Context())
-			mdRecorder <- md
-		}
-
 		// Record the request
 		reqRecord := &executeQueryReqRecord{
 			req: req,
@@ -624,6 +1070,7 @@ func mockExecuteQueryFnWithMetadata(recorder chan<- *executeQueryReqRecord, mdRe
 				break
 			}
 			sleepFor(action.delayStr)
+			setStreamMetadata(srv, action.header, action.trailer)
 
 			if action.rpcError != codes.OK {
 				if action.routingCookie != "" {
@@ -672,12 +1119,6 @@ func mockExecuteQueryFnWithMetadata(recorder chan<- *executeQueryReqRecord, mdRe
 	}
 }
 
-// mockPrepareQueryFn is a simple wrapper of mockPrepareQueryFnWithMetadata. It doesn't record
-// any metadata
-func mockPrepareQueryFn(recorder chan<- *prepareQueryReqRecord, actions ...*prepareQueryAction) func(context.Context, *btpb.PrepareQueryRequest) (*btpb.PrepareQueryResponse, error) {
-	return mockPrepareQueryFnWithMetadata(recorder, nil, actions...)
-}
-
 // mockPrepareQueryFnWithMatchingQuery returns a mock implementation of server-side PrepareQuery(). The behavior is
 // customized by `queryMap`. Non-nil `recorder` will be used to log the requests
 // (including retries) received by the server in time order, up to its capacity.
@@ -690,11 +1131,11 @@ func mockPrepareQueryFnWithMatchingQuery(recorder chan<- *prepareQueryReqRecord,
 	}
 }
 
-// mockPrepareQueryFnWithMetadata returns a mock implementation of server-side PrepareQuery(). The behavior is
+// mockPrepareQueryFn returns a mock implementation of server-side PrepareQuery(). The behavior is
 // customized by `actions`. Non-nil `recorder` will be used to log the requests
 // (including retries) received by the server in time order, up to its capacity.
 // The prepareQueryActions in actions will be used in order for each request.
-func mockPrepareQueryFnWithMetadata(recorder chan<- *prepareQueryReqRecord, mdRecorder chan metadata.MD, actions ...*prepareQueryAction) func(context.Context, *btpb.PrepareQueryRequest) (*btpb.PrepareQueryResponse, error) {
+func mockPrepareQueryFn(recorder chan<- *prepareQueryReqRecord, actions ...*prepareQueryAction) func(context.Context, *btpb.PrepareQueryRequest) (*btpb.PrepareQueryResponse, error) {
 	// Convert the action sequence to a queue for server to consume.
 	actionQueue := make(chan *prepareQueryAction, len(actions))
 	for _, action := range actions {
@@ -708,12 +1149,6 @@ func mockPrepareQueryFnWithMetadata(recorder chan<- *prepareQueryReqRecord, mdRe
 			serverLogger.Printf("Request from client: %+v", req)
 		}
 
-		// Record the metadata
-		if mdRecorder != nil {
-			md, _ := metadata.FromIncomingContext(ctx)
-			mdRecorder <- md
-		}
-
 		// Record the request
 		reqRecord := &prepareQueryReqRecord{
 			req: req,
@@ -722,9 +1157,13 @@ func mockPrepareQueryFnWithMetadata(recorder chan<- *prepareQueryReqRecord, mdRe
 		saveReqRecord(recorder, reqRecord)
 
 		// Perform the action
-		action := <-actionQueue
+		action, more := <-actionQueue
+		if !more {
+			return nil, gs.Error(codes.Internal, "PrepareQuery failed: no action left for the request")
+		}
 
 		sleepFor(action.delayStr)
+		setUnaryMetadata(ctx, action.header, action.trailer)
 
 		if action.rpcError != codes.OK {
 			return nil, gs.Error(action.rpcError, "PrepareQuery failed")
@@ -733,3 +1172,264 @@ func mockPrepareQueryFnWithMetadata(recorder chan<- *prepareQueryReqRecord, mdRe
 		return action.response, nil
 	}
 }
+
+// mockReadChangeStreamFnSimple is a simple wrapper of mockReadChangeStreamFn. It's useful when there
+// is only one partition to read, as users don't need to assemble an array of action sequences.
+func mockReadChangeStreamFnSimple(recorder chan<- *readChangeStreamReqRecord, actions ...*readChangeStreamAction) func(*btpb.ReadChangeStreamRequest, btpb.Bigtable_ReadChangeStreamServer) error {
+	return mockReadChangeStreamFn(recorder, actions)
+}
+
+// mockReadChangeStreamFn returns a mock implementation of server-side ReadChangeStream(). The
+// behavior is customized by `actionSequences`. Non-nil `recorder` will be used to log the requests
+// (including retries) received by the server in time order, up to its capacity.
+// For concurrency testing, the start key of each requested partition MUST have prefix "opX-",
+// indicating that the X-th (zero based) actionSequence will be used to serve the request.
+func mockReadChangeStreamFn(recorder chan<- *readChangeStreamReqRecord, actionSequences ...[]*readChangeStreamAction) func(*btpb.ReadChangeStreamRequest, btpb.Bigtable_ReadChangeStreamServer) error {
+	// Build the map so that server can retrieve the proper action queue by key "opX-".
+	opIDToActionQueue := make(map[string]chan *readChangeStreamAction)
+	buildActionMap(opIDToActionQueue, actionSequences)
+
+	return func(req *btpb.ReadChangeStreamRequest, srv btpb.Bigtable_ReadChangeStreamServer) error {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &readChangeStreamReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Select the actions to perform
+		rowRange := req.GetPartition().GetRowRange()
+		rowKey := rowRange.GetStartKeyClosed()
+		if len(rowKey) == 0 {
+			rowKey = rowRange.GetStartKeyOpen()
+		}
+		actionQueue, err := retrieveActions(opIDToActionQueue, rowKey)
+		if err != nil {
+			return err
+		}
+
+		// Perform the actions
+		for {
+			action, more := <-actionQueue
+			if !more {
+				return nil
+			}
+			sleepFor(action.delayStr)
+			setStreamMetadata(srv, action.header, action.trailer)
+
+			if action.rpcError != codes.OK {
+				return buildActionError("ReadChangeStream", action.rpcError, action.retryInfo)
+			}
+
+			res := &btpb.ReadChangeStreamResponse{}
+			switch {
+			case action.dataChange != nil:
+				res.StreamRecord = &btpb.ReadChangeStreamResponse_DataChange_{DataChange: action.dataChange}
+			case action.heartbeat != nil:
+				res.StreamRecord = &btpb.ReadChangeStreamResponse_Heartbeat_{Heartbeat: action.heartbeat}
+			case action.closeStream != nil:
+				res.StreamRecord = &btpb.ReadChangeStreamResponse_CloseStream_{CloseStream: action.closeStream}
+			}
+			if res.StreamRecord != nil {
+				srv.Send(res)
+			}
+
+			// CloseStream is always the last message of the stream.
+			if action.endOfStream || action.closeStream != nil {
+				return nil
+			}
+		}
+	}
+}
+
+// mockGenerateInitialChangeStreamPartitionsFn returns a mock implementation of server-side
+// GenerateInitialChangeStreamPartitions(). The behavior is customized by `actions`, which will be
+// used in order for each request. Non-nil `recorder` will be used to log the requests (including
+// retries) received by the server in time order, up to its capacity.
+func mockGenerateInitialChangeStreamPartitionsFn(recorder chan<- *generateInitialChangeStreamPartitionsReqRecord, actions ...*generateInitialChangeStreamPartitionsAction) func(*btpb.GenerateInitialChangeStreamPartitionsRequest, btpb.Bigtable_GenerateInitialChangeStreamPartitionsServer) error {
+	// Convert the action sequence to a queue for server to consume.
+	actionQueue := make(chan *generateInitialChangeStreamPartitionsAction, len(actions))
+	for _, action := range actions {
+		action.Validate()
+		actionQueue <- action
+	}
+	close(actionQueue)
+
+	return func(req *btpb.GenerateInitialChangeStreamPartitionsRequest, srv btpb.Bigtable_GenerateInitialChangeStreamPartitionsServer) error {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &generateInitialChangeStreamPartitionsReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Perform the action
+		action, more := <-actionQueue
+		if !more {
+			return gs.Error(codes.Internal, "GenerateInitialChangeStreamPartitions received more requests than the actions")
+		}
+		sleepFor(action.delayStr)
+
+		if action.rpcError != codes.OK {
+			return gs.Error(action.rpcError, "GenerateInitialChangeStreamPartitions failed")
+		}
+
+		for _, partition := range action.partitions {
+			srv.Send(&btpb.GenerateInitialChangeStreamPartitionsResponse{Partition: partition})
+		}
+		return nil
+	}
+}
+
+// mockPingAndWarmFn returns a mock implementation of server-side PingAndWarm(). The behavior is
+// customized by `actions`, which are used in order for each request; once they are used up, the
+// server keeps succeeding. Non-nil `recorder` will be used to log the requests received by the
+// server in time order, up to its capacity.
+func mockPingAndWarmFn(recorder chan<- *pingAndWarmReqRecord, actions ...*pingAndWarmAction) func(context.Context, *btpb.PingAndWarmRequest) (*btpb.PingAndWarmResponse, error) {
+	// Convert the action sequence to a queue for server to consume.
+	actionQueue := make(chan *pingAndWarmAction, len(actions))
+	for _, action := range actions {
+		action.Validate()
+		actionQueue <- action
+	}
+	close(actionQueue)
+
+	return func(ctx context.Context, req *btpb.PingAndWarmRequest) (*btpb.PingAndWarmResponse, error) {
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &pingAndWarmReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Perform the action
+		action, more := <-actionQueue
+		if !more {
+			return &btpb.PingAndWarmResponse{}, nil
+		}
+		sleepFor(action.delayStr)
+
+		if action.rpcError != codes.OK {
+			return nil, gs.Error(action.rpcError, "PingAndWarm failed")
+		}
+		return &btpb.PingAndWarmResponse{}, nil
+	}
+}
+
+// mockTableAdminFn returns a mock implementation of the server-side table admin `method`. The
+// behavior is customized by `actions`, which will be used in order for each request, and the
+// response of a successful action is built by `respond`. Non-nil `recorder` will be used to log
+// the requests (including retries) received by the server in time order, up to its capacity.
+func mockTableAdminFn[Req proto.Message, Res any](method string, recorder chan<- *tableAdminReqRecord, respond func(*tableAdminAction, Req) Res, actions ...*tableAdminAction) func(context.Context, Req) (Res, error) {
+	// Convert the action sequence to a queue for server to consume.
+	actionQueue := make(chan *tableAdminAction, len(actions))
+	for _, action := range actions {
+		action.Validate()
+		actionQueue <- action
+	}
+	close(actionQueue)
+
+	return func(ctx context.Context, req Req) (Res, error) {
+		var none Res
+		if *printClientReq {
+			serverLogger.Printf("Request from client: %+v", req)
+		}
+
+		// Record the request
+		reqRecord := &tableAdminReqRecord{
+			req: req,
+			ts:  time.Now(),
+		}
+		saveReqRecord(recorder, reqRecord)
+
+		// Perform the action
+		action, more := <-actionQueue
+		if !more {
+			return none, gs.Error(codes.Internal, method+" received more requests than the actions")
+		}
+		sleepFor(action.delayStr)
+
+		if action.rpcError != codes.OK {
+			return none, buildActionError(method, action.rpcError, action.retryInfo)
+		}
+
+		return respond(action, req), nil
+	}
+}
+
+// mockCreateTableFn returns a mock implementation of server-side CreateTable(). See
+// mockTableAdminFn() for the use of `recorder` and `actions`. Unless specified by the action, the
+// returned table is built from the table ID and column families in the request.
+func mockCreateTableFn(recorder chan<- *tableAdminReqRecord, actions ...*tableAdminAction) func(context.Context, *adminpb.CreateTableRequest) (*adminpb.Table, error) {
+	return mockTableAdminFn("CreateTable", recorder,
+		func(action *tableAdminAction, req *adminpb.CreateTableRequest) *adminpb.Table {
+			if action.table != nil {
+				return action.table
+			}
+			return &adminpb.Table{
+				Name:           req.GetParent() + "/tables/" + req.GetTableId(),
+				ColumnFamilies: req.GetTable().GetColumnFamilies(),
+			}
+		}, actions...)
+}
+
+// mockDeleteTableFn returns a mock implementation of server-side DeleteTable(). See
+// mockTableAdminFn() for the use of `recorder` and `actions`.
+func mockDeleteTableFn(recorder chan<- *tableAdminReqRecord, actions ...*tableAdminAction) func(context.Context, *adminpb.DeleteTableRequest) (*emptypb.Empty, error) {
+	return mockTableAdminFn("DeleteTable", recorder,
+		func(*tableAdminAction, *adminpb.DeleteTableRequest) *emptypb.Empty {
+			return &emptypb.Empty{}
+		}, actions...)
+}
+
+// mockModifyColumnFamiliesFn returns a mock implementation of server-side ModifyColumnFamilies().
+// See mockTableAdminFn() for the use of `recorder` and `actions`. Unless specified by the action,
+// the returned table only has the table name in the request.
+func mockModifyColumnFamiliesFn(recorder chan<- *tableAdminReqRecord, actions ...*tableAdminAction) func(context.Context, *adminpb.ModifyColumnFamiliesRequest) (*adminpb.Table, error) {
+	return mockTableAdminFn("ModifyColumnFamilies", recorder,
+		func(action *tableAdminAction, req *adminpb.ModifyColumnFamiliesRequest) *adminpb.Table {
+			if action.table != nil {
+				return action.table
+			}
+			return &adminpb.Table{Name: req.GetName()}
+		}, actions...)
+}
+
+// mockDropRowRangeFn returns a mock implementation of server-side DropRowRange(). See
+// mockTableAdminFn() for the use of `recorder` and `actions`.
+func mockDropRowRangeFn(recorder chan<- *tableAdminReqRecord, actions ...*tableAdminAction) func(context.Context, *adminpb.DropRowRangeRequest) (*emptypb.Empty, error) {
+	return mockTableAdminFn("DropRowRange", recorder,
+		func(*tableAdminAction, *adminpb.DropRowRangeRequest) *emptypb.Empty {
+			return &emptypb.Empty{}
+		}, actions...)
+}
+
+// mockGenerateConsistencyTokenFn returns a mock implementation of server-side
+// GenerateConsistencyToken(). See mockTableAdminFn() for the use of `recorder` and `actions`.
+func mockGenerateConsistencyTokenFn(recorder chan<- *tableAdminReqRecord, actions ...*tableAdminAction) func(context.Context, *adminpb.GenerateConsistencyTokenRequest) (*adminpb.GenerateConsistencyTokenResponse, error) {
+	return mockTableAdminFn("GenerateConsistencyToken", recorder,
+		func(action *tableAdminAction, _ *adminpb.GenerateConsistencyTokenRequest) *adminpb.GenerateConsistencyTokenResponse {
+			return &adminpb.GenerateConsistencyTokenResponse{ConsistencyToken: action.consistencyToken}
+		}, actions...)
+}
+
+// mockCheckConsistencyFn returns a mock implementation of server-side CheckConsistency(). See
+// mockTableAdminFn() for the use of `recorder` and `actions`.
+func mockCheckConsistencyFn(recorder chan<- *tableAdminReqRecord, actions ...*tableAdminAction) func(context.Context, *adminpb.CheckConsistencyRequest) (*adminpb.CheckConsistencyResponse, error) {
+	return mockTableAdminFn("CheckConsistency", recorder,
+		func(action *tableAdminAction, _ *adminpb.CheckConsistencyRequest) *adminpb.CheckConsistencyResponse {
+			return &adminpb.CheckConsistencyResponse{Consistent: action.consistent}
+		}, actions...)
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the stand-in of Cloud Monitoring, which the mock server
+// serves next to the Bigtable API, so that a client created with a
+// metrics_target can export its built-in metrics to the mock server. The
+// server also reports the cluster and the zone of the responses, which the
+// clients attach to the metrics. The actions of the mock functions can send
+// these headers as well, see serverTimingMD() and locationMD().
+package tests
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	monitoringpb "cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// clientMetricPrefix prefixes the types of the built-in client-side metrics.
+	clientMetricPrefix = "bigtable.googleapis.com/internal/client/"
+	// locationHeader carries the serialized btpb.ResponseParams with the cluster and the zone that
+	// serve a response.
+	locationHeader = "x-goog-ext-425905942-bin"
+	// serverTimingHeader carries the latency of a response at the Google front end, i.e., gfet4t7.
+	serverTimingHeader = "server-timing"
+)
+
+// metricService is a fake Cloud Monitoring MetricService, which records the time series exported
+// by the clients.
+type metricService struct {
+	monitoringpb.UnimplementedMetricServiceServer
+
+	mu       sync.Mutex
+	series   []*monitoringpb.TimeSeries
+	location metadata.MD // The location header of the Bigtable responses, nil if none.
+}
+
+// CreateTimeSeries records the time series of the request.
+func (m *metricService) CreateTimeSeries(ctx context.Context, req *monitoringpb.CreateTimeSeriesRequest) (*emptypb.Empty, error) {
+	m.record(req.GetTimeSeries())
+	return &emptypb.Empty{}, nil
+}
+
+// CreateServiceTimeSeries records the time series of the request.
+func (m *metricService) CreateServiceTimeSeries(ctx context.Context, req *monitoringpb.CreateTimeSeriesRequest) (*emptypb.Empty, error) {
+	m.record(req.GetTimeSeries())
+	return &emptypb.Empty{}, nil
+}
+
+func (m *metricService) record(series []*monitoringpb.TimeSeries) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.series = append(m.series, series...)
+}
+
+// setHeader sets the location header of a Bigtable response in `set`, if there is a location.
+func (m *metricService) setHeader(fullMethod string, set func(metadata.MD) error) {
+	m.mu.Lock()
+	location := m.location
+	m.mu.Unlock()
+	if location == nil || !strings.HasPrefix(fullMethod, "/google.bigtable.v2.Bigtable/") {
+		return
+	}
+	if err := set(location); err != nil {
+		serverLogger.Printf("Failed to set the location header of %s: %v", fullMethod, err)
+	}
+}
+
+// unaryInterceptor sets the location header of unary RPCs.
+func (m *metricService) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	m.setHeader(info.FullMethod, func(md metadata.MD) error { return grpc.SetHeader(ctx, md) })
+	return handler(ctx, req)
+}
+
+// streamInterceptor sets the location header of streaming RPCs.
+func (m *metricService) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	m.setHeader(info.FullMethod, ss.SetHeader)
+	return handler(srv, ss)
+}
+
+// serverTimingMD returns the server-timing header of a response that the Google front end takes
+// `d` to serve, which the clients record as the server latency.
+func serverTimingMD(d time.Duration) metadata.MD {
+	return metadata.Pairs(serverTimingHeader, fmt.Sprintf("gfet4t7; dur=%d", d.Milliseconds()))
+}
+
+// locationMD returns the location header of a response served by `cluster` in `zone`.
+func locationMD(cluster string, zone string) metadata.MD {
+	location, err := proto.Marshal(&btpb.ResponseParams{ClusterId: &cluster, ZoneId: &zone})
+	if err != nil {
+		serverLogger.Printf("Failed to marshal the location: %v", err)
+		return nil
+	}
+	return metadata.Pairs(locationHeader, string(location))
+}
+
+// setLocation makes the server report that the Bigtable responses are served by `cluster` in
+// `zone`. It shouldn't be combined with the location headers of the actions, as the clients would
+// receive both.
+func (s *Server) setLocation(cluster string, zone string) {
+	s.metrics.mu.Lock()
+	defer s.metrics.mu.Unlock()
+	s.metrics.location = locationMD(cluster, zone)
+}
+
+// timeSeries returns the latest point of every time series of the client metric `name` (e.g.,
+// "retry_count") exported so far. The metrics are cumulative, so a time series that is exported
+// several times, i.e., with the same labels, is only returned once.
+func (s *Server) timeSeries(name string) []*monitoringpb.TimeSeries {
+	s.metrics.mu.Lock()
+	defer s.metrics.mu.Unlock()
+	var keys []string
+	latest := make(map[string]*monitoringpb.TimeSeries)
+	for _, ts := range s.metrics.series {
+		if ts.GetMetric().GetType() != clientMetricPrefix+name {
+			continue
+		}
+		key := fmt.Sprint(ts.GetMetric().GetLabels(), ts.GetResource().GetLabels())
+		if _, ok := latest[key]; !ok {
+			keys = append(keys, key)
+		}
+		latest[key] = ts
+	}
+	series := make([]*monitoringpb.TimeSeries, 0, len(keys))
+	for _, key := range keys {
+		series = append(series, latest[key])
+	}
+	return series
+}
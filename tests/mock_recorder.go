@@ -0,0 +1,237 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the call recorder of the mock server. Unlike the
+// "<operation>ReqRecord" channels, which are filled by the mock functions up
+// to their capacity, the recorder is attached to the server by the outermost
+// interceptors, so every call is recorded without limit, including those
+// rejected by the auth checks and the fault rules.
+package tests
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sync"
+	"time"
+
+	adminpb "cloud.google.com/go/bigtable/admin/apiv2/adminpb"
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	gs "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// callRecord is a call received by the mock server.
+type callRecord struct {
+	method   string        // Method name, e.g., "ReadRows".
+	opID     string        // "opX-" prefix of the first row key in the request, "" if there is none.
+	attempt  int           // One-based index among the calls with the same method and op ID.
+	req      proto.Message // nil if the call fails before the request is received.
+	md       metadata.MD
+	peer     string       // Address of the client.
+	deadline time.Time    // Deadline of the attempt from the grpc-timeout header, zero if there is none.
+	trace    traceContext // Trace context propagated by the client, zero if traceErr is set.
+	traceErr error        // Why the call has no valid trace context, see attemptTraceContext().
+	start    time.Time
+	end      time.Time // Zero if the call is still in progress.
+	err      error     // Status of the call, nil if the call succeeds or is still in progress.
+}
+
+// callRecorder records the calls received by a mock server.
+type callRecorder struct {
+	mu      sync.Mutex
+	records []*callRecord
+	changed chan struct{} // Closed and replaced whenever a record is added or completed.
+}
+
+func newCallRecorder() *callRecorder {
+	return &callRecorder{changed: make(chan struct{})}
+}
+
+// notifyLocked wakes up the waiters of the recorder. The caller must hold `r.mu`.
+func (r *callRecorder) notifyLocked() {
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+// start adds a record of `fullMethod` with the request `req` and the context `ctx`.
+func (r *callRecorder) start(ctx context.Context, fullMethod string, req proto.Message) *callRecord {
+	md, _ := metadata.FromIncomingContext(ctx)
+	record := &callRecord{
+		method: path.Base(fullMethod),
+		opID:   string(rowKeyPrefixRegex.Find(firstRowKey(req))),
+		req:    req,
+		md:     md,
+		start:  time.Now(),
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		record.peer = p.Addr.String()
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		record.deadline = deadline
+	}
+	record.trace, record.traceErr = attemptTraceContext(md)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record.attempt = 1
+	for _, other := range r.records {
+		if other.method == record.method && other.opID == record.opID {
+			record.attempt++
+		}
+	}
+	r.records = append(r.records, record)
+	r.notifyLocked()
+	return record
+}
+
+// end completes `record` with the status `err`.
+func (r *callRecorder) end(record *callRecord, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	record.end = time.Now()
+	record.err = err
+	r.notifyLocked()
+}
+
+// filter returns the records of `method` and `opID` in time order, where "" matches any. It also
+// returns a channel that is closed on the next change of the recorder.
+func (r *callRecorder) filter(method string, opID string) ([]*callRecord, <-chan struct{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var result []*callRecord
+	for _, record := range r.records {
+		if (method == "" || record.method == method) && (opID == "" || record.opID == opID) {
+			result = append(result, record)
+		}
+	}
+	return result, r.changed
+}
+
+// wait returns the records of `method` and `opID` once there are at least `n` of them. An error is
+// returned with the records so far if it takes longer than `timeout`.
+func (r *callRecorder) wait(method string, opID string, n int, timeout time.Duration) ([]*callRecord, error) {
+	deadline := time.After(timeout)
+	for {
+		records, changed := r.filter(method, opID)
+		if len(records) >= n {
+			return records, nil
+		}
+		select {
+		case <-changed:
+		case <-deadline:
+			return records, fmt.Errorf("got %d %q records of op %q after %v, want %d", len(records), method, opID, timeout, n)
+		}
+	}
+}
+
+// unaryInterceptor records unary RPCs.
+func (r *callRecorder) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	record := r.start(ctx, info.FullMethod, req.(proto.Message))
+	res, err := handler(ctx, req)
+	r.end(record, err)
+	return res, err
+}
+
+// streamInterceptor records streaming RPCs.
+func (r *callRecorder) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if info.IsClientStream {
+		record := r.start(ss.Context(), info.FullMethod, nil)
+		err := handler(srv, ss)
+		r.end(record, err)
+		return err
+	}
+
+	req, prefetched, err := prefetchRequest(ss, info.FullMethod)
+	record := r.start(ss.Context(), info.FullMethod, req)
+	if err != nil {
+		r.end(record, err)
+		return err
+	}
+	err = handler(srv, prefetched)
+	r.end(record, err)
+	return err
+}
+
+// prefetchRequest receives the only request of a server-streaming RPC of `fullMethod` from `ss`
+// upfront. It returns the request, and a stream that returns it again on the first RecvMsg(), so
+// that the handlers don't notice.
+func prefetchRequest(ss grpc.ServerStream, fullMethod string) (proto.Message, grpc.ServerStream, error) {
+	if prefetched, ok := ss.(*prefetchedStream); ok {
+		return prefetched.req, prefetched, nil
+	}
+	reqType, _, err := methodTypes(fullMethod)
+	if err != nil {
+		return nil, nil, gs.Errorf(codes.Internal, "unknown method %s: %v", fullMethod, err)
+	}
+	req := reqType.New().Interface()
+	if err := ss.RecvMsg(req); err != nil {
+		return nil, nil, err
+	}
+	return req, &prefetchedStream{ServerStream: ss, req: req}, nil
+}
+
+// prefetchedStream wraps a server stream whose request is received upfront.
+type prefetchedStream struct {
+	grpc.ServerStream
+	req      proto.Message
+	returned bool
+}
+
+func (s *prefetchedStream) RecvMsg(m any) error {
+	if !s.returned {
+		s.returned = true
+		proto.Merge(m.(proto.Message), s.req)
+		return nil
+	}
+	return s.ServerStream.RecvMsg(m)
+}
+
+// firstRowKey returns the first row key in `req`, or nil if there is none.
+func firstRowKey(req proto.Message) []byte {
+	switch r := req.(type) {
+	case *btpb.ReadRowsRequest:
+		if keys := r.GetRows().GetRowKeys(); len(keys) > 0 {
+			return keys[0]
+		}
+		if ranges := r.GetRows().GetRowRanges(); len(ranges) > 0 {
+			if key := ranges[0].GetStartKeyClosed(); len(key) > 0 {
+				return key
+			}
+			return ranges[0].GetStartKeyOpen()
+		}
+	case *btpb.MutateRowRequest:
+		return r.GetRowKey()
+	case *btpb.MutateRowsRequest:
+		if entries := r.GetEntries(); len(entries) > 0 {
+			return entries[0].GetRowKey()
+		}
+	case *btpb.CheckAndMutateRowRequest:
+		return r.GetRowKey()
+	case *btpb.ReadModifyWriteRowRequest:
+		return r.GetRowKey()
+	case *btpb.ReadChangeStreamRequest:
+		if key := r.GetPartition().GetRowRange().GetStartKeyClosed(); len(key) > 0 {
+			return key
+		}
+		return r.GetPartition().GetRowRange().GetStartKeyOpen()
+	case *adminpb.DropRowRangeRequest:
+		return r.GetRowKeyPrefix()
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the routing of concurrent requests to independent mock
+// functions. The mock functions built by mock<Method>Fn() tell apart the
+// concurrent operations by the "opX-" prefix of the first row key, which
+// doesn't work for the requests without row keys, e.g., SampleRowKeys, range
+// scans and ExecuteQuery. Instead, the routed mock functions pick a mock
+// function per request by a routing key, e.g., the table name or the app
+// profile, so that each concurrent operation gets its own scripted behavior.
+package tests
+
+import (
+	"context"
+	"net/url"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	gs "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// routeFunc returns the routing key of the request `req` received with the context `ctx`.
+type routeFunc func(ctx context.Context, req proto.Message) string
+
+// stringField returns the value of the string field `name` of `req`, or "" if there is none.
+func stringField(req proto.Message, name protoreflect.Name) string {
+	m := req.ProtoReflect()
+	field := m.Descriptor().Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind {
+		return ""
+	}
+	return m.Get(field).String()
+}
+
+// routeByTableName routes the requests by their full table names, e.g.,
+// "projects/project/instances/instance/tables/table" (see buildTableName()). It doesn't apply to
+// ExecuteQuery and PrepareQuery, whose requests have an instance name instead, so their routing
+// key is always ""; route them by app profile instead.
+func routeByTableName(ctx context.Context, req proto.Message) string {
+	return stringField(req, "table_name")
+}
+
+// routeByAppProfile routes the requests by their app profile IDs. The ID is taken from the request,
+// or from the "x-goog-request-params" header if the request doesn't have it.
+func routeByAppProfile(ctx context.Context, req proto.Message) string {
+	if profile := stringField(req, "app_profile_id"); profile != "" {
+		return profile
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, params := range md.Get("x-goog-request-params") {
+		values, err := url.ParseQuery(params)
+		if err != nil {
+			continue
+		}
+		if profile := values.Get("app_profile_id"); profile != "" {
+			return profile
+		}
+	}
+	return ""
+}
+
+// routeError returns the error for a request of `method` whose routing key `key` has no mock
+// function.
+func routeError(method string, key string) error {
+	return gs.Errorf(codes.InvalidArgument, "%s failed: didn't find mock function with routing key %q", method, key)
+}
+
+// routeUnaryFn returns a mock implementation of a unary method, e.g., MutateRow, which serves each
+// request with the function in `fns` keyed by the routing key of the request from `route`. The
+// functions can be built by mock<Method>Fn() as usual.
+//
+// Sample code for routing MutateRow requests by table name:
+//
+//	server.MutateRowFn = routeUnaryFn("MutateRow", routeByTableName,
+//		map[string]func(context.Context, *btpb.MutateRowRequest) (*btpb.MutateRowResponse, error){
+//			buildTableName("table0"): mockMutateRowFnSimple(nil, action0),
+//			buildTableName("table1"): mockMutateRowFnSimple(nil, action1),
+//		})
+func routeUnaryFn[Req proto.Message, Res any](method string, route routeFunc, fns map[string]func(context.Context, Req) (Res, error)) func(context.Context, Req) (Res, error) {
+	return func(ctx context.Context, req Req) (Res, error) {
+		key := route(ctx, req)
+		fn, ok := fns[key]
+		if !ok {
+			var none Res
+			return none, routeError(method, key)
+		}
+		return fn(ctx, req)
+	}
+}
+
+// routeStreamFn is the variant of routeUnaryFn() for the server-streaming methods, e.g., ReadRows
+// and SampleRowKeys.
+func routeStreamFn[Req proto.Message, Srv interface{ Context() context.Context }](method string, route routeFunc, fns map[string]func(Req, Srv) error) func(Req, Srv) error {
+	return func(req Req, srv Srv) error {
+		key := route(srv.Context(), req)
+		fn, ok := fns[key]
+		if !ok {
+			return routeError(method, key)
+		}
+		return fn(req, srv)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the optional storage layer of the mock server. Without
+// it, the mock functions serve hand-assembled responses; with it, mutations are
+// really applied to in-memory tables and reads are served from the stored
+// data, so a write followed by a read can be checked end to end.
+package tests
+
+import (
+	"context"
+	"io"
+
+	"cloud.google.com/go/bigtable"
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"cloud.google.com/go/bigtable/bttest"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// tableStore keeps tables, column families, cells and their versions in memory.
+// It is backed by the Cloud Bigtable emulator, so the semantics of row filters,
+// mutations and garbage collection are the same as the ones in the emulator.
+type tableStore struct {
+	emulator *bttest.Server
+	conn     *grpc.ClientConn
+	client   btpb.BigtableClient
+	admin    *bigtable.AdminClient
+}
+
+// newTableStore creates an empty tableStore. Tables need to be created via createTable()
+// before they can serve any data request.
+func newTableStore() (*tableStore, error) {
+	emulator, err := bttest.NewServer("localhost:0")
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpc.NewClient(emulator.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		emulator.Close()
+		return nil, err
+	}
+	admin, err := bigtable.NewAdminClient(
+		context.Background(), projectID, instanceID, option.WithGRPCConn(conn))
+	if err != nil {
+		conn.Close()
+		emulator.Close()
+		return nil, err
+	}
+	return &tableStore{
+		emulator: emulator,
+		conn:     conn,
+		client:   btpb.NewBigtableClient(conn),
+		admin:    admin,
+	}, nil
+}
+
+// createTable creates a table with ID `tableID` and the column families `families`.
+// Each family keeps all the versions of its cells.
+func (s *tableStore) createTable(tableID string, families ...string) error {
+	ctx := context.Background()
+	if err := s.admin.CreateTable(ctx, tableID); err != nil {
+		return err
+	}
+	for _, family := range families {
+		if err := s.admin.CreateColumnFamily(ctx, tableID, family); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// close releases the resources of the store. Stored data will be lost.
+func (s *tableStore) close() {
+	s.admin.Close()
+	s.conn.Close()
+	s.emulator.Close()
+}
+
+// forwardContext returns a context for calling the store on behalf of the request with
+// incoming context `ctx`. The client's feature flags are forwarded, as they affect the responses
+// (e.g., whether the last scanned row keys are returned).
+func forwardContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return metadata.NewOutgoingContext(ctx, metadata.MD{"bigtable-features": md.Get("bigtable-features")})
+}
+
+// readRowsStream serves rows of a ReadRows request from the store one at a time, so that the
+// mock server can interleave them with errors and delays.
+type readRowsStream struct {
+	stream btpb.Bigtable_ReadRowsClient
+	cancel context.CancelFunc
+}
+
+// readRows starts reading rows from the store for `req`.
+func (s *tableStore) readRows(ctx context.Context, req *btpb.ReadRowsRequest) (*readRowsStream, error) {
+	ctx, cancel := context.WithCancel(forwardContext(ctx))
+	stream, err := s.client.ReadRows(ctx, req)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &readRowsStream{stream: stream, cancel: cancel}, nil
+}
+
+// next returns the next response of the stream, which holds either the chunks of a single
+// row or the last scanned row key. io.EOF is returned when there are no more rows.
+func (r *readRowsStream) next() (*btpb.ReadRowsResponse, error) {
+	return r.stream.Recv()
+}
+
+// close stops the stream without consuming the remaining rows.
+func (r *readRowsStream) close() {
+	r.cancel()
+}
+
+// mutateRow applies the mutations of `req` to the store.
+func (s *tableStore) mutateRow(ctx context.Context, req *btpb.MutateRowRequest) (*btpb.MutateRowResponse, error) {
+	return s.client.MutateRow(forwardContext(ctx), req)
+}
+
+// mutateRows applies the mutations of `req` to the store, and returns the result of each entry
+// indexed the same way as in `req`.
+func (s *tableStore) mutateRows(ctx context.Context, req *btpb.MutateRowsRequest) ([]*btpb.MutateRowsResponse_Entry, error) {
+	stream, err := s.client.MutateRows(forwardContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	var entries []*btpb.MutateRowsResponse_Entry
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF && len(entries) == len(req.GetEntries()) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, res.GetEntries()...)
+	}
+}
+
+// checkAndMutateRow performs the conditional mutation of `req` on the store.
+func (s *tableStore) checkAndMutateRow(ctx context.Context, req *btpb.CheckAndMutateRowRequest) (*btpb.CheckAndMutateRowResponse, error) {
+	return s.client.CheckAndMutateRow(forwardContext(ctx), req)
+}
+
+// readModifyWriteRow performs the read-modify-write of `req` on the store.
+func (s *tableStore) readModifyWriteRow(ctx context.Context, req *btpb.ReadModifyWriteRowRequest) (*btpb.ReadModifyWriteRowResponse, error) {
+	return s.client.ReadModifyWriteRow(forwardContext(ctx), req)
+}
+
+// sampleRowKeys returns the sampled row keys of the table in `req`. The last sample always
+// has an empty row key, which indicates the end of the table.
+func (s *tableStore) sampleRowKeys(ctx context.Context, req *btpb.SampleRowKeysRequest) ([]*btpb.SampleRowKeysResponse, error) {
+	stream, err := s.client.SampleRowKeys(forwardContext(ctx), req)
+	if err != nil {
+		return nil, err
+	}
+	var samples []*btpb.SampleRowKeysResponse
+	for {
+		res, err := stream.Recv()
+		if err == io.EOF && len(samples) > 0 && len(samples[len(samples)-1].GetRowKey()) == 0 {
+			return samples, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		samples = append(samples, res)
+	}
+}
@@ -0,0 +1,634 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the record and replay of the traffic seen by the mock
+// server. In recording mode, every call is saved with its metadata, requests,
+// responses and status to a JSON file. In replay mode, the recorded responses
+// are served back, and the new requests are diffed against the recorded ones.
+// The mock functions still get the new requests in the background, with their
+// responses discarded, so that the request recorders of the tests keep
+// working. The interceptors sit inside the call recorder and the metrics ones,
+// and outside the auth and fault ones, so the recording includes the injected
+// faults and auth errors, and the headers and trailers that they and the mock
+// functions set. The calls to the metric service are not recorded, as the
+// exported metrics vary from run to run. The HTTP/2 faults are injected below
+// the gRPC server, so the test injects them again in replay mode, and the
+// recording only keeps them to check that they are the same.
+package tests
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	spb "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	gs "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// trafficMode tells what the traffic interceptors of the mock server do.
+type trafficMode int
+
+const (
+	trafficPassThrough trafficMode = iota // Calls are served by the mock functions only.
+	trafficRecord                         // Calls are served by the mock functions and recorded.
+	trafficReplay                         // Calls are served from the recording.
+)
+
+// trafficFile is the content of a recording file, which holds the calls received by one mock
+// server in a test.
+type trafficFile struct {
+	Test            string         `json:"test"`
+	TransportFaults []string       `json:"transport_faults,omitempty"` // See transportInjector.describe().
+	Calls           []*trafficCall `json:"calls"`
+}
+
+// trafficCall is a call received by the mock server. Durations follow
+// https://pkg.go.dev/time#ParseDuration and are relative to the start of the call. The values of
+// the binary metadata, i.e., with the "-bin" suffix, are base64-encoded.
+type trafficCall struct {
+	Method    string              `json:"method"` // Full method, e.g., "/google.bigtable.v2.Bigtable/ReadRows".
+	Start     time.Time           `json:"start"`
+	Metadata  map[string][]string `json:"metadata"`
+	Requests  []json.RawMessage   `json:"requests"`
+	Header    map[string][]string `json:"header,omitempty"`
+	Responses []*trafficResponse  `json:"responses"`
+	Trailer   map[string][]string `json:"trailer,omitempty"`
+	Status    json.RawMessage     `json:"status"` // google.rpc.Status of the call.
+	Duration  string              `json:"duration"`
+	// Abandoned tells that the client canceled the call, or its deadline passed, before the
+	// server ended it, e.g., with a delay or a RST_STREAM. The status is then the one of the
+	// cancellation.
+	Abandoned bool `json:"abandoned,omitempty"`
+
+	stopWatch func() bool // Stops the check for the cancellation of the call.
+}
+
+// trafficResponse is a response sent by the mock server.
+type trafficResponse struct {
+	Offset  string          `json:"offset"`
+	Message json.RawMessage `json:"message"`
+}
+
+// trafficTape records the calls received by a mock server, or serves them back.
+type trafficTape struct {
+	mu    sync.Mutex
+	mode  trafficMode
+	test  string
+	calls []*trafficCall
+	used  []bool   // Whether the recorded calls have been replayed, in replay mode.
+	diffs []string // Mismatches between the recorded and the new requests, in replay mode.
+
+	transportFaults []string // The HTTP/2 faults of the recording, in replay mode.
+}
+
+// startRecording makes the tape record the calls for the test named `test`.
+func (tp *trafficTape) startRecording(test string) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	tp.mode = trafficRecord
+	tp.test = test
+}
+
+// save writes the recorded calls to `file`, together with the HTTP/2 faults of the server.
+func (tp *trafficTape) save(file string, transportFaults []string) error {
+	tp.mu.Lock()
+	content, err := json.MarshalIndent(&trafficFile{Test: tp.test, TransportFaults: transportFaults, Calls: tp.calls}, "", "  ")
+	tp.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(file, content, 0644)
+}
+
+// startReplaying loads the calls recorded in `file`, and makes the tape serve them back.
+func (tp *trafficTape) startReplaying(file string) error {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	var recording trafficFile
+	if err := json.Unmarshal(content, &recording); err != nil {
+		return fmt.Errorf("invalid recording %s: %v", file, err)
+	}
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	tp.mode = trafficReplay
+	tp.test = recording.Test
+	tp.calls = recording.Calls
+	tp.used = make([]bool, len(recording.Calls))
+	tp.transportFaults = recording.TransportFaults
+	return nil
+}
+
+// mismatches returns the differences between the recording and the replayed calls so far,
+// including the recorded calls that haven't been replayed, and the HTTP/2 faults of the server
+// that differ from the recorded ones.
+func (tp *trafficTape) mismatches(transportFaults []string) []string {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	result := append([]string(nil), tp.diffs...)
+	if diff := cmp.Diff(tp.transportFaults, transportFaults); diff != "" {
+		result = append(result, fmt.Sprintf("HTTP/2 faults differ (-recorded +new):\n%s", diff))
+	}
+	for i, call := range tp.calls {
+		if !tp.used[i] {
+			result = append(result, fmt.Sprintf("recorded call #%d to %s is not received", i, call.Method))
+		}
+	}
+	return result
+}
+
+// currentMode returns the mode of the tape for the calls of `fullMethod`. The calls to the metric
+// service always pass through.
+func (tp *trafficTape) currentMode(fullMethod string) trafficMode {
+	if !strings.HasPrefix(fullMethod, "/google.bigtable.") {
+		return trafficPassThrough
+	}
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	return tp.mode
+}
+
+// appendMD appends the values of `md` to `dst`, with the binary ones base64-encoded, and returns
+// the result.
+func appendMD(dst map[string][]string, md metadata.MD) map[string][]string {
+	if dst == nil {
+		dst = map[string][]string{}
+	}
+	for k, values := range md {
+		for _, v := range values {
+			if strings.HasSuffix(k, "-bin") {
+				v = base64.StdEncoding.EncodeToString([]byte(v))
+			}
+			dst[k] = append(dst[k], v)
+		}
+	}
+	return dst
+}
+
+// recordedMD returns the recorded metadata `m`, with the binary values decoded.
+func recordedMD(m map[string][]string) (metadata.MD, error) {
+	md := metadata.MD{}
+	for k, values := range m {
+		for _, v := range values {
+			if strings.HasSuffix(k, "-bin") {
+				decoded, err := base64.StdEncoding.DecodeString(v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid binary value of %s: %v", k, err)
+				}
+				v = string(decoded)
+			}
+			md.Append(k, v)
+		}
+	}
+	return md, nil
+}
+
+// methodTypes returns the request and response types of `fullMethod`.
+func methodTypes(fullMethod string) (protoreflect.MessageType, protoreflect.MessageType, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(fullMethod, "/"), "/", "."))
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	method, ok := desc.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("%s is not a method", name)
+	}
+	reqType, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	resType, err := protoregistry.GlobalTypes.FindMessageByName(method.Output().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return reqType, resType, nil
+}
+
+// startCall adds a call to the recording, and returns it for the interceptors to fill in. If the
+// client gives up on the call before the interceptors end it, the call ends as abandoned.
+func (tp *trafficTape) startCall(ctx context.Context, fullMethod string) *trafficCall {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md["authorization"]) > 0 {
+		md = md.Copy()
+		md.Set("authorization", "<redacted>")
+	}
+	call := &trafficCall{
+		Method:   fullMethod,
+		Start:    time.Now(),
+		Metadata: appendMD(nil, md),
+	}
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	tp.calls = append(tp.calls, call)
+	call.stopWatch = context.AfterFunc(ctx, func() {
+		st, _ := protojson.Marshal(gs.FromContextError(ctx.Err()).Proto())
+
+		tp.mu.Lock()
+		defer tp.mu.Unlock()
+		if call.Status == nil {
+			call.Status = st
+			call.Duration = time.Since(call.Start).String()
+			call.Abandoned = true
+		}
+	})
+	return call
+}
+
+// addRequest adds `req` to `call`.
+func (tp *trafficTape) addRequest(call *trafficCall, req any) {
+	msg, _ := protojson.Marshal(req.(proto.Message))
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	call.Requests = append(call.Requests, msg)
+}
+
+// addHeader adds the response header `md` to `call`.
+func (tp *trafficTape) addHeader(call *trafficCall, md metadata.MD) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	call.Header = appendMD(call.Header, md)
+}
+
+// addTrailer adds the response trailer `md` to `call`.
+func (tp *trafficTape) addTrailer(call *trafficCall, md metadata.MD) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	call.Trailer = appendMD(call.Trailer, md)
+}
+
+// addResponse adds `res` to `call`, unless the call is abandoned.
+func (tp *trafficTape) addResponse(call *trafficCall, res any) {
+	msg, _ := protojson.Marshal(res.(proto.Message))
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	if call.Abandoned {
+		return
+	}
+	call.Responses = append(call.Responses, &trafficResponse{
+		Offset:  time.Since(call.Start).String(),
+		Message: msg,
+	})
+}
+
+// endCall adds the status `err` to `call`, unless the call is abandoned.
+func (tp *trafficTape) endCall(call *trafficCall, err error) {
+	call.stopWatch()
+	st, _ := protojson.Marshal(gs.Convert(err).Proto())
+
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+	if call.Abandoned {
+		return
+	}
+	call.Status = st
+	call.Duration = time.Since(call.Start).String()
+}
+
+// selectCall returns the first recorded call of `fullMethod` not replayed yet. The one whose first
+// request equals `req` is preferred; otherwise the difference is saved as a mismatch.
+func (tp *trafficTape) selectCall(ctx context.Context, fullMethod string, req proto.Message) (*trafficCall, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	candidate := -1
+	var candidateReq proto.Message
+	for i, call := range tp.calls {
+		if tp.used[i] || call.Method != fullMethod || len(call.Requests) == 0 {
+			continue
+		}
+		recordedReq := req.ProtoReflect().New().Interface()
+		if err := protojson.Unmarshal(call.Requests[0], recordedReq); err != nil {
+			return nil, gs.Errorf(codes.Internal, "invalid recorded request of %s: %v", fullMethod, err)
+		}
+		if diffRecordedRequest(recordedReq, req) == "" {
+			candidate, candidateReq = i, nil
+			break
+		}
+		if candidate < 0 {
+			candidate, candidateReq = i, recordedReq
+		}
+	}
+
+	method := path.Base(fullMethod)
+	if candidate < 0 {
+		tp.diffs = append(tp.diffs, fmt.Sprintf("unexpected call to %s: %v", method, req))
+		return nil, gs.Errorf(codes.Internal, "%s failed: no recorded call to replay", method)
+	}
+	if candidateReq != nil {
+		tp.diffs = append(tp.diffs, fmt.Sprintf("request of recorded call #%d to %s differs (-recorded +new):\n%s",
+			candidate, method, diffRecordedRequest(candidateReq, req)))
+	}
+
+	call := tp.calls[candidate]
+	tp.used[candidate] = true
+	md, _ := metadata.FromIncomingContext(ctx)
+	if diff := cmp.Diff(call.Metadata["x-goog-request-params"], md.Get("x-goog-request-params")); diff != "" {
+		tp.diffs = append(tp.diffs, fmt.Sprintf("x-goog-request-params of recorded call #%d to %s differs (-recorded +new):\n%s",
+			candidate, method, diff))
+	}
+	return call, nil
+}
+
+// diffRecordedRequest returns the difference between the `recorded` and the `received` request.
+// Timestamps are ignored, as they are usually derived from the current time.
+func diffRecordedRequest(recorded proto.Message, received proto.Message) string {
+	return cmp.Diff(recorded, received, protocmp.Transform(), protocmp.IgnoreMessages(&timestamppb.Timestamp{}))
+}
+
+// replaySink is where a recorded call is replayed, i.e., the server stream of a streaming RPC, or
+// unaryReplay.
+type replaySink interface {
+	SetHeader(metadata.MD) error
+	SendMsg(any) error
+	SetTrailer(metadata.MD)
+}
+
+// unaryReplay keeps the response of a replayed unary RPC.
+type unaryReplay struct {
+	ctx context.Context
+	res any
+}
+
+func (u *unaryReplay) SetHeader(md metadata.MD) error { return grpc.SetHeader(u.ctx, md) }
+func (u *unaryReplay) SendMsg(m any) error            { u.res = m; return nil }
+func (u *unaryReplay) SetTrailer(md metadata.MD)      { grpc.SetTrailer(u.ctx, md) }
+
+// replayCall sends the recorded header, responses and trailer of `call` to `sink` at their recorded
+// offsets, and returns the recorded status. If the call was abandoned, it's kept open after the
+// responses until the client gives up on it again. The call ends early with the status of `ctx`,
+// if the client cancels it or its deadline passes.
+func replayCall(ctx context.Context, call *trafficCall, resType protoreflect.MessageType, sink replaySink) error {
+	start := time.Now()
+	waitUntil := func(offset string) error {
+		d, _ := time.ParseDuration(offset)
+		timer := time.NewTimer(time.Until(start.Add(d)))
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			return nil
+		case <-ctx.Done():
+			return gs.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	header, err := recordedMD(call.Header)
+	if err != nil {
+		return gs.Errorf(codes.Internal, "invalid recorded header of %s: %v", call.Method, err)
+	}
+	trailer, err := recordedMD(call.Trailer)
+	if err != nil {
+		return gs.Errorf(codes.Internal, "invalid recorded trailer of %s: %v", call.Method, err)
+	}
+	if len(header) > 0 {
+		if err := sink.SetHeader(header); err != nil {
+			return err
+		}
+	}
+	for _, recorded := range call.Responses {
+		res := resType.New().Interface()
+		if err := protojson.Unmarshal(recorded.Message, res); err != nil {
+			return gs.Errorf(codes.Internal, "invalid recorded response of %s: %v", call.Method, err)
+		}
+		if err := waitUntil(recorded.Offset); err != nil {
+			return err
+		}
+		if err := sink.SendMsg(res); err != nil {
+			return err
+		}
+	}
+
+	if call.Abandoned || call.Status == nil {
+		// The server didn't end the call in the recording, e.g., the test finished first.
+		<-ctx.Done()
+		return gs.FromContextError(ctx.Err()).Err()
+	}
+	st := &spb.Status{}
+	if err := protojson.Unmarshal(call.Status, st); err != nil {
+		return gs.Errorf(codes.Internal, "invalid recorded status of %s: %v", call.Method, err)
+	}
+	if err := waitUntil(call.Duration); err != nil {
+		return err
+	}
+	if len(trailer) > 0 {
+		sink.SetTrailer(trailer)
+	}
+	return gs.ErrorProto(st)
+}
+
+// unaryInterceptor records or replays unary RPCs.
+func (tp *trafficTape) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	switch tp.currentMode(info.FullMethod) {
+	case trafficRecord:
+		call := tp.startCall(ctx, info.FullMethod)
+		tp.addRequest(call, req)
+		stream := &recordingTransportStream{ServerTransportStream: grpc.ServerTransportStreamFromContext(ctx), tape: tp, call: call}
+		res, err := handler(grpc.NewContextWithServerTransportStream(ctx, stream), req)
+		if err == nil {
+			tp.addResponse(call, res)
+		}
+		tp.endCall(call, err)
+		return res, err
+	case trafficReplay:
+		call, err := tp.selectCall(ctx, info.FullMethod, req.(proto.Message))
+		go handler(shadowContext(ctx, info.FullMethod), req)
+		if err != nil {
+			return nil, err
+		}
+		_, resType, err := methodTypes(info.FullMethod)
+		if err != nil {
+			return nil, gs.Errorf(codes.Internal, "unknown method %s: %v", info.FullMethod, err)
+		}
+		sink := &unaryReplay{ctx: ctx}
+		if err := replayCall(ctx, call, resType, sink); err != nil {
+			return nil, err
+		}
+		return sink.res, nil
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor records or replays streaming RPCs.
+func (tp *trafficTape) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	switch tp.currentMode(info.FullMethod) {
+	case trafficRecord:
+		call := tp.startCall(ss.Context(), info.FullMethod)
+		stream := &recordingStream{ServerStream: ss, tape: tp, call: call}
+		if !info.IsClientStream {
+			// Receive the only request upfront, so that it's recorded even if the call is
+			// rejected before the mock function reads it.
+			req, prefetched, err := prefetchRequest(ss, info.FullMethod)
+			if err != nil {
+				tp.endCall(call, err)
+				return err
+			}
+			tp.addRequest(call, req)
+			stream.ServerStream = prefetched
+		}
+		err := handler(srv, stream)
+		tp.endCall(call, err)
+		return err
+	case trafficReplay:
+		reqType, resType, err := methodTypes(info.FullMethod)
+		if err != nil {
+			return gs.Errorf(codes.Internal, "unknown method %s: %v", info.FullMethod, err)
+		}
+		req := reqType.New().Interface()
+		if err := ss.RecvMsg(req); err != nil {
+			return err
+		}
+		call, err := tp.selectCall(ss.Context(), info.FullMethod, req)
+		go handler(srv, &shadowStream{ServerStream: ss, ctx: shadowContext(ss.Context(), info.FullMethod), req: req})
+		if err != nil {
+			return err
+		}
+		return replayCall(ss.Context(), call, resType, ss)
+	}
+	return handler(srv, ss)
+}
+
+// recordingStream wraps a server stream to record the header, responses and trailer of a
+// streaming RPC. The request is recorded upfront, as the Bigtable APIs have no client-streaming
+// RPCs.
+type recordingStream struct {
+	grpc.ServerStream
+	tape *trafficTape
+	call *trafficCall
+}
+
+func (s *recordingStream) SendMsg(m any) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.tape.addResponse(s.call, m)
+	}
+	return err
+}
+
+func (s *recordingStream) SetHeader(md metadata.MD) error {
+	err := s.ServerStream.SetHeader(md)
+	if err == nil {
+		s.tape.addHeader(s.call, md)
+	}
+	return err
+}
+
+func (s *recordingStream) SendHeader(md metadata.MD) error {
+	err := s.ServerStream.SendHeader(md)
+	if err == nil {
+		s.tape.addHeader(s.call, md)
+	}
+	return err
+}
+
+func (s *recordingStream) SetTrailer(md metadata.MD) {
+	s.ServerStream.SetTrailer(md)
+	s.tape.addTrailer(s.call, md)
+}
+
+// recordingTransportStream wraps the transport stream of a unary RPC to record its header and
+// trailer.
+type recordingTransportStream struct {
+	grpc.ServerTransportStream
+	tape *trafficTape
+	call *trafficCall
+}
+
+func (s *recordingTransportStream) SetHeader(md metadata.MD) error {
+	err := s.ServerTransportStream.SetHeader(md)
+	if err == nil {
+		s.tape.addHeader(s.call, md)
+	}
+	return err
+}
+
+func (s *recordingTransportStream) SendHeader(md metadata.MD) error {
+	err := s.ServerTransportStream.SendHeader(md)
+	if err == nil {
+		s.tape.addHeader(s.call, md)
+	}
+	return err
+}
+
+func (s *recordingTransportStream) SetTrailer(md metadata.MD) error {
+	err := s.ServerTransportStream.SetTrailer(md)
+	if err == nil {
+		s.tape.addTrailer(s.call, md)
+	}
+	return err
+}
+
+// shadowTransportStream discards the headers and trailers set by the mock functions of the
+// replayed calls.
+type shadowTransportStream struct {
+	method string
+}
+
+func (s shadowTransportStream) Method() string                  { return s.method }
+func (s shadowTransportStream) SetHeader(md metadata.MD) error  { return nil }
+func (s shadowTransportStream) SendHeader(md metadata.MD) error { return nil }
+func (s shadowTransportStream) SetTrailer(md metadata.MD) error { return nil }
+
+// shadowContext returns the context for the mock function of a replayed call, which is done with
+// the call.
+func shadowContext(ctx context.Context, fullMethod string) context.Context {
+	return grpc.NewContextWithServerTransportStream(ctx, shadowTransportStream{method: fullMethod})
+}
+
+// shadowStream runs the mock function of a replayed streaming call: it receives the request of the
+// call, and discards the responses, headers and trailers.
+type shadowStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message // The request not received yet, if any.
+}
+
+func (s *shadowStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *shadowStream) RecvMsg(m any) error {
+	if s.req == nil {
+		return io.EOF
+	}
+	proto.Merge(m.(proto.Message), s.req)
+	s.req = nil
+	return nil
+}
+
+func (s *shadowStream) SendMsg(m any) error             { return nil }
+func (s *shadowStream) SetHeader(md metadata.MD) error  { return nil }
+func (s *shadowStream) SendHeader(md metadata.MD) error { return nil }
+func (s *shadowStream) SetTrailer(md metadata.MD)       {}
@@ -0,0 +1,519 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the HTTP/2 fault injection layer of the mock server. When
+// transport faults are requested, each accepted connection is relayed frame by
+// frame to the gRPC server, so that GOAWAY and RST_STREAM frames can be
+// injected at a given position of a response stream, and the number of
+// concurrent streams can be limited. The relay parses the plaintext HTTP/2
+// frames, so it's not available on a TLS-enabled server.
+package tests
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"sync"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+// transportFaultKind is the kind of an HTTP/2 fault.
+type transportFaultKind int
+
+const (
+	// rstStream resets the stream with a RST_STREAM frame.
+	rstStream transportFaultKind = iota
+	// goAway sends a GOAWAY frame and then closes the connection.
+	goAway
+)
+
+// transportFault declares a fault that the mock server injects at the HTTP/2 level.
+// Usage:
+//  1. transportFault{kind: rstStream, method: "ReadRows", errCode: code}
+//     Effect: every ReadRows stream will be reset with the error code before any response is sent.
+//  2. transportFault{kind: rstStream, method: "ReadRows", attempt: 1, afterMessages: n, errCode: code}
+//     Effect: server will send n responses of the first ReadRows request, and then reset the stream.
+//  3. transportFault{kind: goAway, method: "MutateRows", attempt: 1, errCode: code}
+//     Effect: server will send GOAWAY with the error code and close the connection, when it's about
+//     to respond to the first MutateRows request.
+//
+// A fault is applied when the server starts to send the response message number afterMessages+1,
+// so it has no effect on the streams that conclude with fewer messages.
+type transportFault struct {
+	kind          transportFaultKind
+	method        string // Method name, e.g., "ReadRows". "" means any method.
+	attempt       int    // One-based index among the matching requests. 0 means every request.
+	afterMessages int    // The number of responses sent before the fault.
+	errCode       http2.ErrCode
+
+	seen int // The number of matching requests, including the current one.
+}
+
+// transportInjector holds the HTTP/2 faults of a mock server.
+type transportInjector struct {
+	mu         sync.Mutex
+	faults     []*transportFault
+	maxStreams uint32 // 0 means no limit.
+}
+
+// enabled tells whether the connections need to be relayed.
+func (t *transportInjector) enabled() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.faults) > 0 || t.maxStreams > 0
+}
+
+// describe returns a description of every fault and of the limit of concurrent streams, which
+// the recordings of the traffic keep.
+func (t *transportInjector) describe() []string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var result []string
+	for _, fault := range t.faults {
+		kind := "RST_STREAM"
+		if fault.kind == goAway {
+			kind = "GOAWAY"
+		}
+		method := fault.method
+		if method == "" {
+			method = "any method"
+		}
+		result = append(result, fmt.Sprintf("%s (%v) on %s, attempt %d, after %d messages",
+			kind, fault.errCode, method, fault.attempt, fault.afterMessages))
+	}
+	if t.maxStreams > 0 {
+		result = append(result, fmt.Sprintf("at most %d concurrent streams", t.maxStreams))
+	}
+	return result
+}
+
+// selectFault returns the first fault that applies to the request of `fullMethod`, or nil if there
+// is none. The attempts of all the matching faults are counted in the process.
+func (t *transportInjector) selectFault(fullMethod string) *transportFault {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	method := path.Base(fullMethod)
+	var selected *transportFault
+	for _, fault := range t.faults {
+		if fault.method != "" && fault.method != method {
+			continue
+		}
+		fault.seen++
+		if selected == nil && (fault.attempt == 0 || fault.attempt == fault.seen) {
+			selected = fault
+		}
+	}
+	return selected
+}
+
+// faultListener wraps the listener of the mock server, so that the accepted connections can be
+// relayed when there are HTTP/2 faults to inject.
+type faultListener struct {
+	net.Listener
+	injector *transportInjector
+}
+
+func (l *faultListener) Accept() (net.Conn, error) {
+	conn, err := l.Listener.Accept()
+	if err != nil || !l.injector.enabled() {
+		return conn, err
+	}
+	relayed, backend := net.Pipe()
+	r := &h2Relay{
+		client:   conn,
+		backend:  relayed,
+		injector: l.injector,
+		streams:  make(map[uint32]*relayStream),
+	}
+	r.clientFramer = http2.NewFramer(conn, nil)
+	r.backendFramer = http2.NewFramer(relayed, nil)
+	go r.relayRequests()
+	go r.relayResponses()
+	return backend, nil
+}
+
+// relayStream keeps the state of a stream in the relay.
+type relayStream struct {
+	fault *transportFault
+	reset bool // Whether the stream has been reset by a fault.
+
+	// The progress of the response messages, which are length-prefixed.
+	msgs       int
+	prefix     [5]byte
+	prefixRead int
+	remaining  int
+}
+
+// advance consumes the response bytes `data` of the stream, and returns the offset in `data` where
+// the message number limit+1 starts, or -1 if `data` doesn't reach it.
+func (s *relayStream) advance(data []byte, limit int) int {
+	for i := 0; i < len(data); {
+		if s.prefixRead == 0 && s.msgs == limit {
+			return i
+		}
+		if s.prefixRead < len(s.prefix) {
+			s.prefix[s.prefixRead] = data[i]
+			s.prefixRead++
+			i++
+			if s.prefixRead == len(s.prefix) {
+				s.remaining = int(binary.BigEndian.Uint32(s.prefix[1:]))
+			}
+		} else {
+			n := min(s.remaining, len(data)-i)
+			s.remaining -= n
+			i += n
+		}
+		if s.prefixRead == len(s.prefix) && s.remaining == 0 {
+			s.prefixRead = 0
+			s.msgs++
+		}
+	}
+	return -1
+}
+
+// h2Relay relays the frames of a client connection to the gRPC server via `backend`, and injects
+// the HTTP/2 faults. The client's SETTINGS_HEADER_TABLE_SIZE is overridden with 0, so that the
+// server doesn't use HPACK dynamic table, and its header frames can be dropped safely.
+type h2Relay struct {
+	client  net.Conn
+	backend net.Conn
+
+	clientMu      sync.Mutex // Guards the writes to client.
+	clientFramer  *http2.Framer
+	backendMu     sync.Mutex // Guards the writes to backend.
+	backendFramer *http2.Framer
+
+	injector *transportInjector
+
+	mu           sync.Mutex
+	streams      map[uint32]*relayStream
+	active       int
+	lastStreamID uint32
+	closeOnce    sync.Once
+}
+
+// rawFrame is a frame as it's read from the wire.
+type rawFrame struct {
+	header  [9]byte
+	payload []byte
+}
+
+func (f *rawFrame) frameType() http2.FrameType { return http2.FrameType(f.header[3]) }
+func (f *rawFrame) flags() http2.Flags         { return http2.Flags(f.header[4]) }
+func (f *rawFrame) streamID() uint32 {
+	return binary.BigEndian.Uint32(f.header[5:]) & (1<<31 - 1)
+}
+
+func readRawFrame(r io.Reader) (*rawFrame, error) {
+	f := &rawFrame{}
+	if _, err := io.ReadFull(r, f.header[:]); err != nil {
+		return nil, err
+	}
+	length := uint32(f.header[0])<<16 | uint32(f.header[1])<<8 | uint32(f.header[2])
+	f.payload = make([]byte, length)
+	if _, err := io.ReadFull(r, f.payload); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// unpad returns the payload of a DATA or HEADERS frame without padding and priority fields.
+func (f *rawFrame) unpad() []byte {
+	p := f.payload
+	padLen := 0
+	if f.flags().Has(http2.FlagDataPadded) && len(p) > 0 {
+		padLen = int(p[0])
+		p = p[1:]
+	}
+	if f.frameType() == http2.FrameHeaders && f.flags().Has(http2.FlagHeadersPriority) && len(p) >= 5 {
+		p = p[5:]
+	}
+	if padLen > len(p) {
+		return nil
+	}
+	return p[:len(p)-padLen]
+}
+
+func (r *h2Relay) writeClient(f *rawFrame) error {
+	r.clientMu.Lock()
+	defer r.clientMu.Unlock()
+	if _, err := r.client.Write(f.header[:]); err != nil {
+		return err
+	}
+	_, err := r.client.Write(f.payload)
+	return err
+}
+
+func (r *h2Relay) writeBackend(f *rawFrame) error {
+	r.backendMu.Lock()
+	defer r.backendMu.Unlock()
+	if _, err := r.backend.Write(f.header[:]); err != nil {
+		return err
+	}
+	_, err := r.backend.Write(f.payload)
+	return err
+}
+
+// writeBackendAsync writes a frame to backend without blocking the relay of responses.
+func (r *h2Relay) writeBackendAsync(write func(*http2.Framer) error) {
+	go func() {
+		r.backendMu.Lock()
+		defer r.backendMu.Unlock()
+		write(r.backendFramer)
+	}()
+}
+
+func (r *h2Relay) close() {
+	r.closeOnce.Do(func() {
+		r.client.Close()
+		r.backend.Close()
+	})
+}
+
+// endStream drops the stream from the active ones. The streams reset by the relay are kept, so
+// that the frames the server sends afterwards can be dropped.
+func (r *h2Relay) endStream(id uint32) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if s, ok := r.streams[id]; ok && !s.reset {
+		delete(r.streams, id)
+		r.active--
+	}
+}
+
+// relayRequests relays the frames from the client to the gRPC server.
+func (r *h2Relay) relayRequests() {
+	defer r.close()
+
+	preface := make([]byte, len(http2.ClientPreface))
+	if _, err := io.ReadFull(r.client, preface); err != nil {
+		return
+	}
+	r.backendMu.Lock()
+	_, err := r.backend.Write(preface)
+	r.backendMu.Unlock()
+	if err != nil {
+		return
+	}
+
+	var method string
+	decoder := hpack.NewDecoder(4096, func(f hpack.HeaderField) {
+		if f.Name == ":path" {
+			method = f.Value
+		}
+	})
+	var headersStreamID uint32
+	for {
+		f, err := readRawFrame(r.client)
+		if err != nil {
+			return
+		}
+
+		switch f.frameType() {
+		case http2.FrameSettings:
+			if !f.flags().Has(http2.FlagSettingsAck) {
+				f = overrideSettings(f, http2.Setting{ID: http2.SettingHeaderTableSize, Val: 0})
+			}
+		case http2.FrameHeaders, http2.FrameContinuation:
+			if f.frameType() == http2.FrameHeaders {
+				headersStreamID = f.streamID()
+				method = ""
+			}
+			fragment := f.payload
+			if f.frameType() == http2.FrameHeaders {
+				fragment = f.unpad()
+			}
+			decoder.Write(fragment)
+			if f.flags().Has(http2.FlagHeadersEndHeaders) {
+				decoder.Close()
+				// Register the stream before the server can respond to it.
+				refused := r.openStream(headersStreamID, method)
+				if err := r.writeBackend(f); err != nil {
+					return
+				}
+				if refused {
+					r.refuseStream(headersStreamID)
+				}
+				continue
+			}
+		case http2.FrameRSTStream:
+			r.endStream(f.streamID())
+		}
+
+		if err := r.writeBackend(f); err != nil {
+			return
+		}
+	}
+}
+
+// openStream registers a new stream of `fullMethod`, and returns whether it should be refused as
+// the limit of concurrent streams is reached.
+func (r *h2Relay) openStream(id uint32, fullMethod string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if id <= r.lastStreamID {
+		// Trailers of an existing stream.
+		return false
+	}
+	r.lastStreamID = id
+
+	r.injector.mu.Lock()
+	maxStreams := r.injector.maxStreams
+	r.injector.mu.Unlock()
+	if maxStreams > 0 && r.active >= int(maxStreams) {
+		r.streams[id] = &relayStream{reset: true}
+		return true
+	}
+
+	r.streams[id] = &relayStream{fault: r.injector.selectFault(fullMethod)}
+	r.active++
+	return false
+}
+
+// refuseStream resets the stream with ID `id` on both sides, with REFUSED_STREAM for the client.
+func (r *h2Relay) refuseStream(id uint32) {
+	serverLogger.Printf("Stream %d is refused as the limit of concurrent streams is reached", id)
+	r.backendMu.Lock()
+	r.backendFramer.WriteRSTStream(id, http2.ErrCodeCancel)
+	r.backendMu.Unlock()
+	r.clientMu.Lock()
+	r.clientFramer.WriteRSTStream(id, http2.ErrCodeRefusedStream)
+	r.clientMu.Unlock()
+}
+
+// relayResponses relays the frames from the gRPC server to the client, and applies the faults.
+func (r *h2Relay) relayResponses() {
+	defer r.close()
+
+	for {
+		f, err := readRawFrame(r.backend)
+		if err != nil {
+			return
+		}
+
+		r.mu.Lock()
+		s, ok := r.streams[f.streamID()]
+		r.mu.Unlock()
+
+		switch f.frameType() {
+		case http2.FrameSettings:
+			r.injector.mu.Lock()
+			maxStreams := r.injector.maxStreams
+			r.injector.mu.Unlock()
+			if maxStreams > 0 && !f.flags().Has(http2.FlagSettingsAck) {
+				f = overrideSettings(f, http2.Setting{ID: http2.SettingMaxConcurrentStreams, Val: maxStreams})
+			}
+		case http2.FrameHeaders, http2.FrameContinuation:
+			if ok && s.reset {
+				// The server's header frames don't rely on HPACK dynamic table, so they can be dropped.
+				continue
+			}
+			if f.flags().Has(http2.FlagHeadersEndStream) {
+				r.endStream(f.streamID())
+			}
+		case http2.FrameData:
+			if !ok {
+				break
+			}
+			if s.reset {
+				r.returnWindow(len(f.payload))
+				continue
+			}
+			if s.fault != nil {
+				data := f.unpad()
+				if cut := s.advance(data, s.fault.afterMessages); cut >= 0 {
+					if cut > 0 {
+						r.clientMu.Lock()
+						err := r.clientFramer.WriteData(f.streamID(), false, data[:cut])
+						r.clientMu.Unlock()
+						if err != nil {
+							return
+						}
+					}
+					r.returnWindow(len(f.payload) - cut)
+					if !r.applyFault(f.streamID(), s.fault) {
+						return
+					}
+					continue
+				}
+			}
+			if f.flags().Has(http2.FlagDataEndStream) {
+				r.endStream(f.streamID())
+			}
+		case http2.FrameRSTStream:
+			r.endStream(f.streamID())
+		}
+
+		if err := r.writeClient(f); err != nil {
+			return
+		}
+	}
+}
+
+// returnWindow gives the connection-level flow control window of the dropped bytes back to the
+// server, as the client won't see them.
+func (r *h2Relay) returnWindow(n int) {
+	if n <= 0 {
+		return
+	}
+	r.writeBackendAsync(func(fr *http2.Framer) error { return fr.WriteWindowUpdate(0, uint32(n)) })
+}
+
+// applyFault applies `fault` to the stream with ID `id`, and returns whether the relay should go on.
+func (r *h2Relay) applyFault(id uint32, fault *transportFault) bool {
+	switch fault.kind {
+	case goAway:
+		r.mu.Lock()
+		lastStreamID := r.lastStreamID
+		r.mu.Unlock()
+		serverLogger.Printf("GOAWAY (%v) is sent on stream %d", fault.errCode, id)
+		r.clientMu.Lock()
+		r.clientFramer.WriteGoAway(lastStreamID, fault.errCode, nil)
+		r.clientMu.Unlock()
+		return false
+	default:
+		serverLogger.Printf("RST_STREAM (%v) is sent on stream %d", fault.errCode, id)
+		r.mu.Lock()
+		r.streams[id].reset = true
+		r.active--
+		r.mu.Unlock()
+		r.clientMu.Lock()
+		err := r.clientFramer.WriteRSTStream(id, fault.errCode)
+		r.clientMu.Unlock()
+		r.writeBackendAsync(func(fr *http2.Framer) error { return fr.WriteRSTStream(id, http2.ErrCodeCancel) })
+		return err == nil
+	}
+}
+
+// overrideSettings returns a SETTINGS frame with the settings of `f`, where `override` takes the
+// place of the setting with the same ID.
+func overrideSettings(f *rawFrame, override http2.Setting) *rawFrame {
+	out := &rawFrame{header: f.header}
+	for i := 0; i+6 <= len(f.payload); i += 6 {
+		if http2.SettingID(binary.BigEndian.Uint16(f.payload[i:])) != override.ID {
+			out.payload = append(out.payload, f.payload[i:i+6]...)
+		}
+	}
+	out.payload = binary.BigEndian.AppendUint16(out.payload, uint16(override.ID))
+	out.payload = binary.BigEndian.AppendUint32(out.payload, override.Val)
+	out.header[0] = byte(len(out.payload) >> 16)
+	out.header[1] = byte(len(out.payload) >> 8)
+	out.header[2] = byte(len(out.payload))
+	return out
+}
@@ -20,23 +20,56 @@ package tests
 import (
 	"context"
 	"net"
+	"testing"
+	"time"
 
+	adminpb "cloud.google.com/go/bigtable/admin/apiv2/adminpb"
 	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	monitoringpb "cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-// Server is an in-memory Cloud Bigtable fake.
-// It is unauthenticated, and only a rough approximation.
+// Server is an in-memory Cloud Bigtable fake, serving both the data API and the table admin API.
+// It is unauthenticated unless an access token is required, and only a rough approximation.
 type Server struct {
 	Addr string
 
 	l   net.Listener
 	srv *grpc.Server
 
+	// store holds the tables served by the store-backed mock functions. It's nil unless the
+	// server is initialized with a store, and it will be closed together with the server.
+	store *tableStore
+
+	// faults holds the fault rules applied by the interceptors of the server.
+	faults *faultInjector
+
+	// auth checks and records the access token of every request.
+	auth *tokenChecker
+
+	// rootCertsPEM is the PEM encoding of the CA that issued the server certificate. It's empty
+	// unless the server is initialized with TLS.
+	rootCertsPEM string
+
+	// transport holds the HTTP/2 faults applied to the connections accepted afterwards.
+	transport *transportInjector
+
+	// recorder records every call received by the server.
+	recorder *callRecorder
+
+	// traffic records the calls received by the server, or serves them back from a recording.
+	traffic *trafficTape
+
+	// metrics records the client-side metrics exported to the server, and sets the location
+	// header of the Bigtable responses.
+	metrics *metricService
+
 	// Any unimplemented methods will cause a panic when called.
 	btpb.BigtableServer
+	adminpb.BigtableTableAdminServer
 
 	// Assign new functions to these parameters to implement specific mock
 	// functionality.
@@ -57,22 +90,57 @@ type Server struct {
 	ExecuteQueryFn func(*btpb.ExecuteQueryRequest, btpb.Bigtable_ExecuteQueryServer) error
 	// PrepareQueryFn mocks PrepareQuery
 	PrepareQueryFn func(context.Context, *btpb.PrepareQueryRequest) (*btpb.PrepareQueryResponse, error)
+	// ReadChangeStreamFn mocks ReadChangeStream.
+	ReadChangeStreamFn func(*btpb.ReadChangeStreamRequest, btpb.Bigtable_ReadChangeStreamServer) error
+	// GenerateInitialChangeStreamPartitionsFn mocks GenerateInitialChangeStreamPartitions.
+	GenerateInitialChangeStreamPartitionsFn func(*btpb.GenerateInitialChangeStreamPartitionsRequest, btpb.Bigtable_GenerateInitialChangeStreamPartitionsServer) error
+	// PingAndWarmFn mocks PingAndWarm.
+	PingAndWarmFn func(context.Context, *btpb.PingAndWarmRequest) (*btpb.PingAndWarmResponse, error)
+
+	// CreateTableFn mocks CreateTable of the table admin API.
+	CreateTableFn func(context.Context, *adminpb.CreateTableRequest) (*adminpb.Table, error)
+	// DeleteTableFn mocks DeleteTable of the table admin API.
+	DeleteTableFn func(context.Context, *adminpb.DeleteTableRequest) (*emptypb.Empty, error)
+	// ModifyColumnFamiliesFn mocks ModifyColumnFamilies of the table admin API.
+	ModifyColumnFamiliesFn func(context.Context, *adminpb.ModifyColumnFamiliesRequest) (*adminpb.Table, error)
+	// DropRowRangeFn mocks DropRowRange of the table admin API.
+	DropRowRangeFn func(context.Context, *adminpb.DropRowRangeRequest) (*emptypb.Empty, error)
+	// GenerateConsistencyTokenFn mocks GenerateConsistencyToken of the table admin API.
+	GenerateConsistencyTokenFn func(context.Context, *adminpb.GenerateConsistencyTokenRequest) (*adminpb.GenerateConsistencyTokenResponse, error)
+	// CheckConsistencyFn mocks CheckConsistency of the table admin API.
+	CheckConsistencyFn func(context.Context, *adminpb.CheckConsistencyRequest) (*adminpb.CheckConsistencyResponse, error)
 }
 
 // NewServer creates a new Server.
-// The Server will be listening for gRPC connections, without TLS,
-// on the provided address. The resolved address is named by the Addr field.
+// The Server will be listening for gRPC connections on the provided address,
+// without TLS unless `opt` has TLS credentials (see initMockServerWithTLS).
+// The resolved address is named by the Addr field.
 func NewServer(laddr string, opt ...grpc.ServerOption) (*Server, error) {
 	l, err := net.Listen("tcp", laddr)
 	if err != nil {
 		return nil, err
 	}
 
+	faults := &faultInjector{}
+	auth := &tokenChecker{}
+	traffic := &trafficTape{}
+	recorder := newCallRecorder()
+	metrics := &metricService{}
+	opt = append(opt,
+		grpc.ChainUnaryInterceptor(recorder.unaryInterceptor, metrics.unaryInterceptor, traffic.unaryInterceptor, auth.unaryInterceptor, faults.unaryInterceptor),
+		grpc.ChainStreamInterceptor(recorder.streamInterceptor, metrics.streamInterceptor, traffic.streamInterceptor, auth.streamInterceptor, faults.streamInterceptor))
 	srv := grpc.NewServer(opt...)
+	transport := &transportInjector{}
 	s := &Server{
-		Addr: l.Addr().String(),
-		l:    l,
-		srv:  srv,
+		Addr:      l.Addr().String(),
+		l:         &faultListener{Listener: l, injector: transport},
+		srv:       srv,
+		faults:    faults,
+		auth:      auth,
+		transport: transport,
+		recorder:  recorder,
+		traffic:   traffic,
+		metrics:   metrics,
 	}
 
 	return s, nil
@@ -81,15 +149,115 @@ func NewServer(laddr string, opt ...grpc.ServerOption) (*Server, error) {
 // Start starts the server
 func (s *Server) Start() {
 	btpb.RegisterBigtableServer(s.srv, s)
+	adminpb.RegisterBigtableTableAdminServer(s.srv, s)
+	monitoringpb.RegisterMetricServiceServer(s.srv, s.metrics)
 	go s.srv.Serve(s.l)
 }
 
+// injectFaults adds fault rules to the server. They apply to the requests received afterwards,
+// on top of the behaviors of the mock functions.
+func (s *Server) injectFaults(rules ...*faultRule) {
+	s.faults.addRules(rules...)
+}
+
+// faultRuleSeen returns the number of requests that have matched the fault `rule` of the server.
+func (s *Server) faultRuleSeen(rule *faultRule) int {
+	return s.faults.seen(rule)
+}
+
+// requireAccessToken makes the server reject the requests whose "authorization" header is not
+// "Bearer <token>" with UNAUTHENTICATED.
+func (s *Server) requireAccessToken(token string) {
+	s.auth.mu.Lock()
+	defer s.auth.mu.Unlock()
+	s.auth.token = token
+}
+
+// authRecords returns the "authorization" headers of the requests received so far, in time order.
+func (s *Server) authRecords() []authRecord {
+	s.auth.mu.Lock()
+	defer s.auth.mu.Unlock()
+	return append([]authRecord(nil), s.auth.records...)
+}
+
+// injectTransportFaults adds HTTP/2 faults to the server. They apply to the connections
+// established afterwards, so they should be added before the server is started. The relay that
+// injects them reads plaintext HTTP/2 frames, so they can't be added to a TLS-enabled server.
+func (s *Server) injectTransportFaults(t *testing.T, faults ...*transportFault) {
+	s.requirePlaintext(t)
+	s.transport.mu.Lock()
+	defer s.transport.mu.Unlock()
+	s.transport.faults = append(s.transport.faults, faults...)
+}
+
+// limitConcurrentStreams makes the server advertise SETTINGS_MAX_CONCURRENT_STREAMS of `n` on the
+// connections established afterwards, and refuse the streams beyond the limit with REFUSED_STREAM.
+// Like injectTransportFaults, it can't be used with a TLS-enabled server.
+func (s *Server) limitConcurrentStreams(t *testing.T, n uint32) {
+	s.requirePlaintext(t)
+	s.transport.mu.Lock()
+	defer s.transport.mu.Unlock()
+	s.transport.maxStreams = n
+}
+
+// requirePlaintext fails the test if the server is TLS-enabled, as its connections carry
+// encrypted bytes that the HTTP/2 relay can't parse.
+func (s *Server) requirePlaintext(t *testing.T) {
+	if s.rootCertsPEM != "" {
+		t.Fatalf("HTTP/2 faults can't be injected into a TLS-enabled mock server")
+	}
+}
+
+// callRecords returns the calls of `method` (e.g., "ReadRows") for the operation with ID `opID`
+// (e.g., "op0-") received so far, in time order. "" matches any method or operation.
+func (s *Server) callRecords(method string, opID string) []*callRecord {
+	records, _ := s.recorder.filter(method, opID)
+	return records
+}
+
+// waitForCallRecords waits until the server has received `n` calls of `method` for the operation
+// with ID `opID`, and returns them in time order. An error is returned with the calls so far if it
+// takes longer than `timeout`.
+func (s *Server) waitForCallRecords(method string, opID string, n int, timeout time.Duration) ([]*callRecord, error) {
+	return s.recorder.wait(method, opID, n, timeout)
+}
+
+// recordTraffic makes the server record the calls it receives afterwards for the test named
+// `test`. The recording can be written to a file with saveTraffic().
+func (s *Server) recordTraffic(test string) {
+	s.traffic.startRecording(test)
+}
+
+// saveTraffic writes the recorded calls to `file` in JSON, together with the HTTP/2 faults of the
+// server.
+func (s *Server) saveTraffic(file string) error {
+	return s.traffic.save(file, s.transport.describe())
+}
+
+// replayTraffic makes the server serve the calls recorded in `file`. Each request is served with
+// the first recorded call of the same method that hasn't been replayed, preferring the one with the
+// same request. The mock functions still get the requests, but their responses, headers and
+// trailers are discarded. The HTTP/2 faults are not replayed, so they should be injected as in the
+// recording.
+func (s *Server) replayTraffic(file string) error {
+	return s.traffic.startReplaying(file)
+}
+
+// trafficMismatches returns the differences between the replayed recording and the calls
+// received so far, including the recorded calls not received and the HTTP/2 faults that differ.
+func (s *Server) trafficMismatches() []string {
+	return s.traffic.mismatches(s.transport.describe())
+}
+
 // Close closes the server.
 func (s *Server) Close() error {
 	if err := s.l.Close(); err != nil {
 		return err
 	}
 	s.srv.Stop()
+	if s.store != nil {
+		s.store.close()
+	}
 	return nil
 }
 
@@ -154,3 +322,76 @@ func (s *Server) PrepareQuery(ctx context.Context, req *btpb.PrepareQueryRequest
 	}
 	return nil, status.Error(codes.Unimplemented, "unimplemented - you need to attach a PrepareQueryFn to the server")
 }
+
+// ReadChangeStream implements ReadChangeStream of the BigtableServer interface.
+func (s *Server) ReadChangeStream(req *btpb.ReadChangeStreamRequest, srv btpb.Bigtable_ReadChangeStreamServer) error {
+	if s.ReadChangeStreamFn != nil {
+		return s.ReadChangeStreamFn(req, srv)
+	}
+	return status.Error(codes.Unimplemented, "unimplemented - you need to attach a ReadChangeStreamFn to the server")
+}
+
+// GenerateInitialChangeStreamPartitions implements GenerateInitialChangeStreamPartitions of the
+// BigtableServer interface.
+func (s *Server) GenerateInitialChangeStreamPartitions(req *btpb.GenerateInitialChangeStreamPartitionsRequest, srv btpb.Bigtable_GenerateInitialChangeStreamPartitionsServer) error {
+	if s.GenerateInitialChangeStreamPartitionsFn != nil {
+		return s.GenerateInitialChangeStreamPartitionsFn(req, srv)
+	}
+	return status.Error(codes.Unimplemented, "unimplemented - you need to attach a GenerateInitialChangeStreamPartitionsFn to the server")
+}
+
+// PingAndWarm implements PingAndWarm of the BigtableServer interface.
+func (s *Server) PingAndWarm(ctx context.Context, req *btpb.PingAndWarmRequest) (*btpb.PingAndWarmResponse, error) {
+	if s.PingAndWarmFn != nil {
+		return s.PingAndWarmFn(ctx, req)
+	}
+	return nil, status.Error(codes.Unimplemented, "unimplemented - you need to attach a PingAndWarmFn to the server")
+}
+
+// CreateTable implements CreateTable of the BigtableTableAdminServer interface.
+func (s *Server) CreateTable(ctx context.Context, req *adminpb.CreateTableRequest) (*adminpb.Table, error) {
+	if s.CreateTableFn != nil {
+		return s.CreateTableFn(ctx, req)
+	}
+	return nil, status.Error(codes.Unimplemented, "unimplemented - you need to attach a CreateTableFn to the server")
+}
+
+// DeleteTable implements DeleteTable of the BigtableTableAdminServer interface.
+func (s *Server) DeleteTable(ctx context.Context, req *adminpb.DeleteTableRequest) (*emptypb.Empty, error) {
+	if s.DeleteTableFn != nil {
+		return s.DeleteTableFn(ctx, req)
+	}
+	return nil, status.Error(codes.Unimplemented, "unimplemented - you need to attach a DeleteTableFn to the server")
+}
+
+// ModifyColumnFamilies implements ModifyColumnFamilies of the BigtableTableAdminServer interface.
+func (s *Server) ModifyColumnFamilies(ctx context.Context, req *adminpb.ModifyColumnFamiliesRequest) (*adminpb.Table, error) {
+	if s.ModifyColumnFamiliesFn != nil {
+		return s.ModifyColumnFamiliesFn(ctx, req)
+	}
+	return nil, status.Error(codes.Unimplemented, "unimplemented - you need to attach a ModifyColumnFamiliesFn to the server")
+}
+
+// DropRowRange implements DropRowRange of the BigtableTableAdminServer interface.
+func (s *Server) DropRowRange(ctx context.Context, req *adminpb.DropRowRangeRequest) (*emptypb.Empty, error) {
+	if s.DropRowRangeFn != nil {
+		return s.DropRowRangeFn(ctx, req)
+	}
+	return nil, status.Error(codes.Unimplemented, "unimplemented - you need to attach a DropRowRangeFn to the server")
+}
+
+// GenerateConsistencyToken implements GenerateConsistencyToken of the BigtableTableAdminServer interface.
+func (s *Server) GenerateConsistencyToken(ctx context.Context, req *adminpb.GenerateConsistencyTokenRequest) (*adminpb.GenerateConsistencyTokenResponse, error) {
+	if s.GenerateConsistencyTokenFn != nil {
+		return s.GenerateConsistencyTokenFn(ctx, req)
+	}
+	return nil, status.Error(codes.Unimplemented, "unimplemented - you need to attach a GenerateConsistencyTokenFn to the server")
+}
+
+// CheckConsistency implements CheckConsistency of the BigtableTableAdminServer interface.
+func (s *Server) CheckConsistency(ctx context.Context, req *adminpb.CheckConsistencyRequest) (*adminpb.CheckConsistencyResponse, error) {
+	if s.CheckConsistencyFn != nil {
+		return s.CheckConsistencyFn(ctx, req)
+	}
+	return nil, status.Error(codes.Unimplemented, "unimplemented - you need to attach a CheckConsistencyFn to the server")
+}
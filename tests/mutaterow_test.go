@@ -71,13 +71,9 @@ func TestMutateRow_Generic_Headers(t *testing.T) {
 	tableName := buildTableName(tableID)
 
 	// 1. Instantiate the mock server
-	// Don't call mockMutateRowFn() as the behavior is to record metadata of the request
-	mdRecords := make(chan metadata.MD, 1)
+	// The server records the metadata of every request, so the mock function only responds
 	server := initMockServer(t)
 	server.MutateRowFn = func(ctx context.Context, req *btpb.MutateRowRequest) (*btpb.MutateRowResponse, error) {
-		md, _ := metadata.FromIncomingContext(ctx)
-		mdRecords <- md
-
 		return &btpb.MutateRowResponse{}, nil
 	}
 
@@ -94,7 +90,7 @@ func TestMutateRow_Generic_Headers(t *testing.T) {
 	doMutateRowOp(t, server, &req, &opts)
 
 	// 4. Check the request headers in the metadata
-	md := <-mdRecords
+	md := requireCallRecords(t, server, "MutateRow", "", 1)[0].md
 	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -106,6 +102,199 @@ func TestMutateRow_Generic_Headers(t *testing.T) {
 	assert.Contains(t, resource, profileID)
 }
 
+// TestMutateRow_Retry_Headers tests that every attempt of MutateRow, including the retries,
+// has the client and resource info, an incrementing attempt counter and a stable operation ID.
+func TestMutateRow_Retry_Headers(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	const profileID string = "test_profile"
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.MutateRowFn = mockMutateRowFn(nil, []*mutateRowAction{
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowRequest("table", []byte("row-01"), 1),
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	res := doMutateRowOp(t, server, &req, &opts)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+
+	// 4b. Check the headers of every attempt
+	assert.Equal(t, numRPCs, checkAttemptHeaders(t, server, "MutateRow", "", attemptHeaders{
+		params: map[string]string{"table_name": buildTableName("table"), "app_profile_id": profileID},
+	}))
+}
+
+// TestMutateRow_Retry_ClientMetrics tests that the client exports the built-in metrics of MutateRow,
+// which count the operation and its attempts, with the location reported by the server.
+func TestMutateRow_Retry_ClientMetrics(t *testing.T) {
+	// 0. Common variables
+	const profileID string = "test_profile"
+	const clusterID string = "test-cluster"
+	const zoneID string = "us-central1-b"
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.setLocation(clusterID, zoneID)
+	server.MutateRowFn = mockMutateRowFn(nil, []*mutateRowAction{
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowRequest("table", []byte("row-01"), 1),
+	}
+
+	// 3. Perform the operation via test proxy, where the metrics are exported when the client is closed
+	opts := clientOpts{
+		profile: profileID,
+		metrics: true,
+	}
+	res := doMutateRowOp(t, server, &req, &opts)
+
+	// 4. Check that the operation succeeded, and the metrics count the retries
+	checkResultOkStatus(t, res)
+	checkClientMetrics(t, server, clientMetrics{
+		method:  "Bigtable.MutateRow",
+		profile: profileID,
+		resource: map[string]string{
+			"project_id": projectID, "instance": instanceID, "table": "table", "cluster": clusterID, "zone": zoneID,
+		},
+		points: []metricPoint{
+			{name: "operation_latencies", status: codes.OK, value: 1},
+			{name: "attempt_latencies", status: codes.Unavailable, value: 2},
+			{name: "attempt_latencies", status: codes.OK, value: 1},
+			{name: "retry_count", status: codes.OK, value: 2},
+		},
+	})
+}
+
+// TestMutateRow_NoRetry_ClientMetrics tests that the client exports the built-in metrics of a MutateRow
+// that fails without retries. The server reports no location, so the cluster and zone labels aren't
+// checked, as clients differ in their defaults.
+func TestMutateRow_NoRetry_ClientMetrics(t *testing.T) {
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.MutateRowFn = mockMutateRowFnSimple(nil, &mutateRowAction{rpcError: codes.PermissionDenied})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowRequest("table", []byte("row-01"), 1),
+	}
+
+	// 3. Perform the operation via test proxy, where the metrics are exported when the client is closed
+	opts := clientOpts{
+		metrics: true,
+	}
+	res := doMutateRowOp(t, server, &req, &opts)
+
+	// 4. Check that the operation failed, and the metrics have a single attempt
+	assert.Equal(t, int32(codes.PermissionDenied), res.GetStatus().GetCode())
+	checkClientMetrics(t, server, clientMetrics{
+		method:   "Bigtable.MutateRow",
+		profile:  "",
+		resource: map[string]string{"table": "table"},
+		points: []metricPoint{
+			{name: "operation_latencies", status: codes.PermissionDenied, value: 1},
+			{name: "attempt_latencies", status: codes.PermissionDenied, value: 1},
+			{name: "retry_count", status: codes.PermissionDenied, value: 0},
+		},
+	})
+}
+
+// TestMutateRow_Retry_ServerTimingAndLocation tests that the client attributes the latency of each
+// MutateRow attempt to the server and to the location in its response headers or trailers.
+func TestMutateRow_Retry_ServerTimingAndLocation(t *testing.T) {
+	// 1. Instantiate the mock server, where the failed attempt reports its location in the
+	// trailers, and the successful one in the headers
+	server := initMockServer(t)
+	server.MutateRowFn = mockMutateRowFn(nil, []*mutateRowAction{
+		&mutateRowAction{
+			rpcError: codes.Unavailable,
+			trailer:  metadata.Join(serverTimingMD(20*time.Millisecond), locationMD("cluster-a", "zone-a")),
+		},
+		&mutateRowAction{
+			header: metadata.Join(serverTimingMD(40*time.Millisecond), locationMD("cluster-b", "zone-b")),
+		},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowRequest("table", []byte("row-01"), 1),
+	}
+
+	// 3. Perform the operation via test proxy, where the metrics are exported when the client is closed
+	res := doMutateRowOp(t, server, &req, &clientOpts{metrics: true})
+
+	// 4. Check that the operation succeeded, and each attempt is attributed to its location
+	checkResultOkStatus(t, res)
+	checkClientMetrics(t, server, clientMetrics{
+		method:   "Bigtable.MutateRow",
+		resource: map[string]string{"cluster": "cluster-a", "zone": "zone-a"},
+		points: []metricPoint{
+			{name: "attempt_latencies", status: codes.Unavailable, value: 1},
+			{name: "server_latencies", status: codes.Unavailable, value: 1, mean: 20},
+		},
+	})
+	checkClientMetrics(t, server, clientMetrics{
+		method:   "Bigtable.MutateRow",
+		resource: map[string]string{"cluster": "cluster-b", "zone": "zone-b"},
+		points: []metricPoint{
+			{name: "operation_latencies", status: codes.OK, value: 1},
+			{name: "attempt_latencies", status: codes.OK, value: 1},
+			{name: "server_latencies", status: codes.OK, value: 1, mean: 40},
+		},
+	})
+}
+
+// TestMutateRow_Retry_TraceContext tests that every attempt of MutateRow, including the retries,
+// propagates the trace of the operation in a span of its own.
+func TestMutateRow_Retry_TraceContext(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	parent := newTraceContext()
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.MutateRowFn = mockMutateRowFn(nil, []*mutateRowAction{
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId:    t.Name(),
+		Request:     dummyMutateRowRequest("table", []byte("row-01"), 1),
+		Traceparent: parent.traceparent(),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doMutateRowOp(t, server, &req, nil)
+
+	// 4. Check that the operation succeeded, and every attempt is a span in the trace
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRPCs, len(checkAttemptTraces(t, server, "MutateRow", "", parent)))
+}
+
 // TestMutateRow_NoRetry_NonprintableByteKey tests that client can specify non-printable byte strings as row key.
 func TestMutateRow_NoRetry_NonprintableByteKey(t *testing.T) {
 	// 1. Instantiate the mock server
@@ -288,4 +477,219 @@ func TestMutateRow_Generic_DeadlineExceeded(t *testing.T) {
 
 	// 4b. Check the DeadlineExceeded error
 	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4c. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "MutateRow", "", 2*time.Second)
+}
+
+// TestMutateRow_Retry_DeadlineExceeded tests that client sends what is left of the timeout
+// in the grpc-timeout of every attempt, and stops retrying once the timeout is reached.
+func TestMutateRow_Retry_DeadlineExceeded(t *testing.T) {
+	// 0. Common variables
+	const maxRPCs int = 10 // More than the attempts that fit in the timeout.
+	const timeout = 2 * time.Second
+
+	// 1. Instantiate the mock server
+	var actions []*mutateRowAction
+	for i := 0; i < maxRPCs; i++ {
+		actions = append(actions, &mutateRowAction{rpcError: codes.Unavailable, delayStr: "500ms"})
+	}
+	server := initMockServer(t)
+	server.MutateRowFn = mockMutateRowFn(nil, actions)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowRequest("table", []byte("row-01"), 1),
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: durationpb.New(timeout),
+	}
+	res := doMutateRowOp(t, server, &req, &opts)
+
+	// 4a. Check the DeadlineExceeded error
+	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4b. Check the deadlines of the attempts, and that the client did retry
+	assert.Greater(t, checkAttemptDeadlines(t, server, "MutateRow", "", timeout), 1)
+}
+
+// TestMutateRow_Retry_ExponentialBackoff tests that client will retry using exponential backoff,
+// within the bounds of -backoff_profile.
+func TestMutateRow_Retry_ExponentialBackoff(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 4
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.MutateRowFn = mockMutateRowFn(nil, []*mutateRowAction{
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{rpcError: codes.Unavailable},
+		&mutateRowAction{},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowRequest("table", []byte("row-01"), 1),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doMutateRowOp(t, server, &req, nil)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRPCs, len(server.callRecords("MutateRow", "")))
+
+	// 4b. Check the retry delays
+	checkRetryBackoff(t, server, "MutateRow", "")
+}
+
+// TestMutateRow_Generic_ReadAfterWrite tests that the mutations written by client can be read
+// back from the stored data.
+func TestMutateRow_Generic_ReadAfterWrite(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	const rowKey string = "row-01"
+	const numMutations int = 2
+	clientID := t.Name()
+
+	// 1. Instantiate the mock server, which is backed by a store
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+
+	// 2. Build the requests to test proxy
+	mutateReq := &testproxypb.MutateRowRequest{
+		ClientId: clientID,
+		Request:  dummyMutateRowRequest(tableID, []byte(rowKey), numMutations),
+	}
+	readReq := &testproxypb.ReadRowRequest{
+		ClientId:  clientID,
+		TableName: buildTableName(tableID),
+		RowKey:    rowKey,
+	}
+
+	// 3. Perform the operations via test proxy
+	setUp(t, server, clientID, nil)
+	defer tearDown(t, server, clientID)
+
+	mutateRes := doMutateRowOpsCore(t, clientID, []*testproxypb.MutateRowRequest{mutateReq}, nil)
+	readRes := doReadRowOpsCore(t, clientID, []*testproxypb.ReadRowRequest{readReq}, nil)
+
+	// 4. Check that the written cells were read back
+	checkResultOkStatus(t, mutateRes...)
+	checkResultOkStatus(t, readRes...)
+	assert.Equal(t, rowKey, string(readRes[0].GetRow().GetKey()))
+	columns := readRes[0].GetRow().GetFamilies()[0].GetColumns()
+	assert.Equal(t, numMutations, len(columns))
+	for i, column := range columns {
+		assert.Equal(t, "col_"+strconv.Itoa(i), string(column.GetQualifier()))
+		assert.Equal(t, "value_"+strconv.Itoa(i), string(column.GetCells()[0].GetValue()))
+	}
+}
+
+// TestMutateRow_Generic_SecureConnection tests that client can mutate a row on a TLS-enabled server.
+func TestMutateRow_Generic_SecureConnection(t *testing.T) {
+	// 0. Common variables
+	clientReq := dummyMutateRowRequest("table", []byte("row-01"), 1)
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *mutateRowReqRecord, 1)
+	action := &mutateRowAction{}
+	server := initMockServerWithTLS(t, nil)
+	server.MutateRowFn = mockMutateRowFnSimple(recorder, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  clientReq,
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doMutateRowOp(t, server, &req, tlsClientOpts(server, ""))
+
+	// 4. Check that the operation succeeded over the secure connection
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 1, len(recorder))
+}
+
+// TestMutateRow_Generic_NoAccessTokenWithoutTLS tests that client doesn't send the access token
+// over a plaintext connection.
+func TestMutateRow_Generic_NoAccessTokenWithoutTLS(t *testing.T) {
+	// 0. Common variables
+	const accessToken string = "test-token"
+	clientReq := dummyMutateRowRequest("table", []byte("row-01"), 1)
+
+	// 1. Instantiate the mock server, which doesn't use TLS
+	action := &mutateRowAction{}
+	server := initMockServer(t)
+	server.MutateRowFn = mockMutateRowFnSimple(nil, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  clientReq,
+	}
+
+	// 3. Perform the operation via test proxy. The client may either fail the operation or send
+	// it without the token.
+	opts := clientOpts{
+		timeout: &durationpb.Duration{Seconds: 5},
+		security: &testproxypb.CreateClientRequest_SecurityOptions{
+			AccessToken: accessToken,
+		},
+	}
+	res := doMutateRowOp(t, server, &req, &opts)
+
+	// 4. Check that the token never reached the server, which only gets the request if the
+	// operation succeeded
+	authRecords := server.authRecords()
+	if res.GetStatus().GetCode() == int32(codes.OK) {
+		assert.Equal(t, 1, len(authRecords))
+	} else {
+		assert.Empty(t, authRecords)
+	}
+	for _, record := range authRecords {
+		assert.NotContains(t, record.authorization, accessToken, "%s request", record.method)
+	}
+}
+
+// TestMutateRow_Generic_AccessTokenWithoutTLSWhenAllowed tests that client sends the access token
+// over a plaintext connection when it's told to.
+func TestMutateRow_Generic_AccessTokenWithoutTLSWhenAllowed(t *testing.T) {
+	// 0. Common variables
+	const accessToken string = "test-token"
+	clientReq := dummyMutateRowRequest("table", []byte("row-01"), 1)
+
+	// 1. Instantiate the mock server, which doesn't use TLS but requires the token
+	action := &mutateRowAction{}
+	server := initMockServer(t)
+	server.MutateRowFn = mockMutateRowFnSimple(nil, action)
+	server.requireAccessToken(accessToken)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowRequest{
+		ClientId: t.Name(),
+		Request:  clientReq,
+	}
+
+	// 3. Perform the operation via test proxy, allowing the token without TLS
+	opts := clientOpts{
+		timeout: &durationpb.Duration{Seconds: 5},
+		security: &testproxypb.CreateClientRequest_SecurityOptions{
+			AccessToken:              accessToken,
+			AllowInsecureAccessToken: true,
+		},
+	}
+	res := doMutateRowOp(t, server, &req, &opts)
+
+	// 4. Check that the operation succeeded with the token
+	checkResultOkStatus(t, res)
+	authRecords := server.authRecords()
+	if !assert.Equal(t, 1, len(authRecords)) {
+		return
+	}
+	assert.Equal(t, "Bearer "+accessToken, authRecords[0].authorization)
 }
@@ -29,6 +29,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -101,13 +102,9 @@ func TestMutateRows_Generic_Headers(t *testing.T) {
 	tableName := buildTableName(tableID)
 
 	// 1. Instantiate the mock server
-	// Don't call mockMutateRowsFn() as the behavior is to record metadata of the request
-	mdRecords := make(chan metadata.MD, 1)
+	// The server records the metadata of every request, so the mock function only responds
 	server := initMockServer(t)
 	server.MutateRowsFn = func(req *btpb.MutateRowsRequest, srv btpb.Bigtable_MutateRowsServer) error {
-		md, _ := metadata.FromIncomingContext(srv.Context())
-		mdRecords <- md
-
 		// C++ client requires per-row result to be set, otherwise the client returns Internal error.
 		// For Java client, using "return nil" is enough.
 		res := &btpb.MutateRowsResponse{}
@@ -133,7 +130,7 @@ func TestMutateRows_Generic_Headers(t *testing.T) {
 	doMutateRowsOp(t, server, &req, &opts)
 
 	// 4. Check the request headers in the metadata
-	md := <-mdRecords
+	md := requireCallRecords(t, server, "MutateRows", "", 1)[0].md
 	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -145,6 +142,119 @@ func TestMutateRows_Generic_Headers(t *testing.T) {
 	assert.Contains(t, resource, profileID)
 }
 
+// TestMutateRows_Retry_Headers tests that every attempt of MutateRows, including the retries,
+// has the client and resource info, an incrementing attempt counter and a stable operation ID.
+func TestMutateRows_Retry_Headers(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	const profileID string = "test_profile"
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.MutateRowsFn = mockMutateRowsFn(nil, []*mutateRowsAction{
+		&mutateRowsAction{rpcError: codes.Unavailable},
+		&mutateRowsAction{rpcError: codes.Unavailable},
+		&mutateRowsAction{data: buildEntryData([]int{0}, nil, 0)},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowsRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowsRequest("table", 1),
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	res := doMutateRowsOp(t, server, &req, &opts)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+
+	// 4b. Check the headers of every attempt
+	assert.Equal(t, numRPCs, checkAttemptHeaders(t, server, "MutateRows", "", attemptHeaders{
+		params: map[string]string{"table_name": buildTableName("table"), "app_profile_id": profileID},
+	}))
+}
+
+// TestMutateRows_Retry_ServerTimingAndLocation tests that the client attributes the latency of each
+// MutateRows attempt to the server and to the location in its response headers or trailers.
+func TestMutateRows_Retry_ServerTimingAndLocation(t *testing.T) {
+	// 1. Instantiate the mock server, where the failed attempt reports its location in the
+	// trailers, and the successful one in the headers
+	server := initMockServer(t)
+	server.MutateRowsFn = mockMutateRowsFn(nil, []*mutateRowsAction{
+		&mutateRowsAction{
+			rpcError: codes.Unavailable,
+			trailer:  metadata.Join(serverTimingMD(20*time.Millisecond), locationMD("cluster-a", "zone-a")),
+		},
+		&mutateRowsAction{
+			data:   buildEntryData([]int{0}, nil, 0),
+			header: metadata.Join(serverTimingMD(40*time.Millisecond), locationMD("cluster-b", "zone-b")),
+		},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowsRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowsRequest("table", 1),
+	}
+
+	// 3. Perform the operation via test proxy, where the metrics are exported when the client is closed
+	res := doMutateRowsOp(t, server, &req, &clientOpts{metrics: true})
+
+	// 4. Check that the operation succeeded, and each attempt is attributed to its location
+	checkResultOkStatus(t, res)
+	checkClientMetrics(t, server, clientMetrics{
+		method:   "Bigtable.MutateRows",
+		resource: map[string]string{"cluster": "cluster-a", "zone": "zone-a"},
+		points: []metricPoint{
+			{name: "attempt_latencies", status: codes.Unavailable, value: 1},
+			{name: "server_latencies", status: codes.Unavailable, value: 1, mean: 20},
+		},
+	})
+	checkClientMetrics(t, server, clientMetrics{
+		method:   "Bigtable.MutateRows",
+		resource: map[string]string{"cluster": "cluster-b", "zone": "zone-b"},
+		points: []metricPoint{
+			{name: "operation_latencies", status: codes.OK, value: 1},
+			{name: "attempt_latencies", status: codes.OK, value: 1},
+			{name: "server_latencies", status: codes.OK, value: 1, mean: 40},
+		},
+	})
+}
+
+// TestMutateRows_Retry_TraceContext tests that every attempt of MutateRows, including the retries,
+// propagates the trace of the operation in a span of its own.
+func TestMutateRows_Retry_TraceContext(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	parent := newTraceContext()
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.MutateRowsFn = mockMutateRowsFn(nil, []*mutateRowsAction{
+		&mutateRowsAction{rpcError: codes.Unavailable},
+		&mutateRowsAction{rpcError: codes.Unavailable},
+		&mutateRowsAction{data: buildEntryData([]int{0}, nil, 0)},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowsRequest{
+		ClientId:    t.Name(),
+		Request:     dummyMutateRowsRequest("table", 1),
+		Traceparent: parent.traceparent(),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doMutateRowsOp(t, server, &req, nil)
+
+	// 4. Check that the operation succeeded, and every attempt is a span in the trace
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRPCs, len(checkAttemptTraces(t, server, "MutateRows", "", parent)))
+}
+
 // TestMutateRows_NoRetry_NonTransientErrors tests that client will not retry on non-transient errors.
 func TestMutateRows_NoRetry_NonTransientErrors(t *testing.T) {
 	// 0. Common variables
@@ -184,6 +294,38 @@ func TestMutateRows_NoRetry_NonTransientErrors(t *testing.T) {
 	assert.ElementsMatch(t, failedRowIndices, outputIndices)
 }
 
+// TestMutateRows_Generic_RateLimitInfo tests that client accepts the RateLimitInfo in the responses,
+// including a response without entries, and still gets the result of every row without retries.
+func TestMutateRows_Generic_RateLimitInfo(t *testing.T) {
+	// 0. Common variables
+	const numRows int = 2
+	const tableID string = "table"
+	rateLimitInfo := &btpb.RateLimitInfo{Period: durationpb.New(time.Second), Factor: 0.7}
+
+	// 1. Instantiate the mock server
+	// The first response only carries the RateLimitInfo, and the second one the row results.
+	actions := []*mutateRowsAction{
+		{rateLimitInfo: rateLimitInfo},
+		{data: buildEntryData([]int{0, 1}, nil, codes.OK), endOfStream: true, rateLimitInfo: rateLimitInfo},
+	}
+	server := initMockServer(t)
+	server.MutateRowsFn = mockMutateRowsFn(nil, actions)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowsRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowsRequest(tableID, numRows),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doMutateRowsOp(t, server, &req, nil)
+
+	// 4. Check that the operation succeeded in a single attempt
+	checkResultOkStatus(t, res)
+	assert.Empty(t, res.GetEntries())
+	assert.Len(t, server.callRecords("MutateRows", ""), 1)
+}
+
 // TestMutateRows_Generic_DeadlineExceeded tests that client-side timeout is set and respected.
 func TestMutateRows_Generic_DeadlineExceeded(t *testing.T) {
 	// 0. Common variables
@@ -228,6 +370,43 @@ func TestMutateRows_Generic_DeadlineExceeded(t *testing.T) {
 	for _, entry := range res.GetEntries() {
 		assert.Equal(t, int32(codes.DeadlineExceeded), entry.GetStatus().GetCode())
 	}
+
+	// 4d. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "MutateRows", "", 2*time.Second)
+}
+
+// TestMutateRows_Retry_DeadlineExceeded tests that client sends what is left of the timeout
+// in the grpc-timeout of every attempt, and stops retrying once the timeout is reached.
+func TestMutateRows_Retry_DeadlineExceeded(t *testing.T) {
+	// 0. Common variables
+	const maxRPCs int = 10 // More than the attempts that fit in the timeout.
+	const timeout = 2 * time.Second
+
+	// 1. Instantiate the mock server
+	var actions []*mutateRowsAction
+	for i := 0; i < maxRPCs; i++ {
+		actions = append(actions, &mutateRowsAction{rpcError: codes.Unavailable, delayStr: "500ms"})
+	}
+	server := initMockServer(t)
+	server.MutateRowsFn = mockMutateRowsFn(nil, actions)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowsRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowsRequest("table", 1),
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: durationpb.New(timeout),
+	}
+	res := doMutateRowsOp(t, server, &req, &opts)
+
+	// 4a. Check the DeadlineExceeded error
+	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4b. Check the deadlines of the attempts, and that the client did retry
+	assert.Greater(t, checkAttemptDeadlines(t, server, "MutateRows", "", timeout), 1)
 }
 
 // TestMutateRows_Retry_TransientErrors tests that client will retry transient errors.
@@ -291,9 +470,8 @@ func TestMutateRows_Retry_TransientErrors(t *testing.T) {
 	}
 }
 
-// TestMutateRows_Retry_ExponentialBackoff tests that client will retry using exponential backoff.
-// TODO: as the clients use jitter with different defaults, a correct and reliable check should look
-// at more retry attempts. Before finding the best solution, we drop the check for now.
+// TestMutateRows_Retry_ExponentialBackoff tests that client will retry using exponential backoff,
+// within the bounds of -backoff_profile.
 func TestMutateRows_Retry_ExponentialBackoff(t *testing.T) {
 	// 0. Common variables
 	const numRows int = 1
@@ -329,25 +507,14 @@ func TestMutateRows_Retry_ExponentialBackoff(t *testing.T) {
 	}
 
 	// 3. Perform the operation via test proxy
-	doMutateRowsOp(t, server, &req, nil)
+	res := doMutateRowsOp(t, server, &req, nil)
 
-	// 4a. Check the number of requests in the recorder
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
 	assert.Equal(t, numRPCs, len(recorder))
 
-	// 4b. Log the retry delays
-	origReq := <-recorder
-
-	for n := 1; n < numRPCs; n += 1 {
-		select {
-		case retry := <-recorder:
-			delay := int(retry.ts.UnixMilli() - origReq.ts.UnixMilli())
-			// Different clients may have different behaviors, we log the delays for informational purpose.
-			// Example: For the first retry delay, C++ client uses 100ms but Java client uses 10ms.
-			t.Logf("Retry #%d delay: %dms", n, delay)
-		case <-time.After(500 * time.Millisecond):
-			t.Logf("Retry #%d: Timeout waiting for retry (expecting %d retries)", n, numRPCs-1)
-		}
-	}
+	// 4b. Check the retry delays
+	checkRetryBackoff(t, server, "MutateRows", "")
 }
 
 // TestMutateRows_Generic_MultiStreams tests that client can have multiple concurrent streams.
@@ -473,14 +640,12 @@ func TestMutateRows_Retry_WithRoutingCookie(t *testing.T) {
 	clientReq := dummyMutateRowsRequest(tableID, 1)
 
 	// 1. Instantiate the mock server
-	recorder := make(chan *mutateRowsReqRecord, 2)
-	mdRecorder := make(chan metadata.MD, 2)
 	actions := []*mutateRowsAction{
 		&mutateRowsAction{rpcError: codes.Unavailable, routingCookie: cookie},
 		&mutateRowsAction{data: buildEntryData([]int{0}, nil, 0)},
 	}
 	server := initMockServer(t)
-	server.MutateRowsFn = mockMutateRowsFnWithMetadata(recorder, mdRecorder, actions)
+	server.MutateRowsFn = mockMutateRowsFn(nil, actions)
 
 	// 2. Build the request to test proxy
 	req := testproxypb.MutateRowsRequest{
@@ -495,21 +660,20 @@ func TestMutateRows_Retry_WithRoutingCookie(t *testing.T) {
 	checkResultOkStatus(t, res)
 
 	// 4b. Verify routing cookie is seen
-	// Ignore the first metadata which won't have the routing cookie
-	var _ = <-mdRecorder
-
-	select {
-	case md1 := <-mdRecorder:
-		// second metadata which comes from the retry attempt should have a routing cookie field
-		val := md1["x-goog-cbt-cookie-test"]
-		assert.NotEmpty(t, val)
-		if len(val) == 0 {
-			return
-		}
-		assert.Equal(t, cookie, val[0])
-	case <-time.After(100 * time.Millisecond):
-		t.Error("Timeout waiting for requests on recorder channel")
-	}
+	// The first attempt won't have the routing cookie, and the retry attempt should have it
+	records := requireCallRecords(t, server, "MutateRows", "", 2)
+	val := records[1].md["x-goog-cbt-cookie-test"]
+	assert.NotEmpty(t, val)
+	if len(val) == 0 {
+		return
+	}
+	assert.Equal(t, cookie, val[0])
+
+	// 4c. Check that the routing cookie is only sent on the retries, with the other headers
+	checkAttemptHeaders(t, server, "MutateRows", "", attemptHeaders{
+		params:  map[string]string{"table_name": buildTableName("table")},
+		cookies: []string{"", cookie},
+	})
 }
 
 // TestMutateRows_Retry_WithRetryInfo tests that client is handling RetryInfo correctly.
@@ -520,13 +684,12 @@ func TestMutateRows_Retry_WithRetryInfo(t *testing.T) {
 
 	// 1. Instantiate the mock server
 	recorder := make(chan *mutateRowsReqRecord, 2)
-	mdRecorder := make(chan metadata.MD, 2)
 	actions := []*mutateRowsAction{
 		&mutateRowsAction{rpcError: codes.Unavailable, retryInfo: "2s"},
 		&mutateRowsAction{data: buildEntryData([]int{0}, nil, 0)},
 	}
 	server := initMockServer(t)
-	server.MutateRowsFn = mockMutateRowsFnWithMetadata(recorder, mdRecorder, actions)
+	server.MutateRowsFn = mockMutateRowsFn(recorder, actions)
 
 	// 2. Build the request to test proxy
 	req := testproxypb.MutateRowsRequest{
@@ -548,3 +711,128 @@ func TestMutateRows_Retry_WithRetryInfo(t *testing.T) {
 
 	assert.True(t, retryReqTs-firstReqTs >= 2)
 }
+
+// TestMutateRows_Retry_PartialFailureWithStore tests that client will retry the failed rows only,
+// and that every row is written once the retry succeeds.
+func TestMutateRows_Retry_PartialFailureWithStore(t *testing.T) {
+	// 0. Common variables
+	const numRows int = 3
+	const tableID string = "table"
+	clientID := t.Name()
+
+	// 1. Instantiate the mock server, which is backed by a store
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+	recorder := make(chan *mutateRowsReqRecord, 2)
+	actions := []*mutateRowsAction{
+		&mutateRowsAction{ // row-1 fails, the other rows are written
+			data: buildEntryData(nil, []int{1}, codes.Unavailable),
+		},
+	}
+	server.MutateRowsFn = mockMutateRowsFnWithStore(server.store, recorder, actions)
+
+	// 2. Build the requests to test proxy
+	mutateReq := &testproxypb.MutateRowsRequest{
+		ClientId: clientID,
+		Request:  dummyMutateRowsRequest(tableID, numRows),
+	}
+	readReq := &testproxypb.ReadRowsRequest{
+		ClientId: clientID,
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName(tableID)},
+	}
+
+	// 3. Perform the operations via test proxy
+	setUp(t, server, clientID, nil)
+	defer tearDown(t, server, clientID)
+
+	mutateRes := doMutateRowsOpsCore(t, clientID, []*testproxypb.MutateRowsRequest{mutateReq}, nil)
+	readRes := doReadRowsOpsCore(t, clientID, []*testproxypb.ReadRowsRequest{readReq}, nil)
+
+	// 4a. Check that the write succeeded, and the retry only had the failed row
+	checkResultOkStatus(t, mutateRes...)
+	assert.Equal(t, 2, len(recorder))
+	<-recorder
+	retryReq := <-recorder
+	expectedRetry := dummyMutateRowsRequestCore(tableID, []string{"row-1"})
+	if diff := cmp.Diff(expectedRetry, retryReq.req, protocmp.Transform()); diff != "" {
+		t.Errorf("diff found (-want +got):\n%s", diff)
+	}
+
+	// 4b. Check that all the rows were written
+	checkResultOkStatus(t, readRes...)
+	assert.Equal(t, numRows, len(readRes[0].GetRows()))
+}
+
+// TestMutateRows_Retry_RstStream tests that client will retry the rows whose results were lost as
+// the stream is reset by RST_STREAM, and that every row is written once the retry succeeds.
+func TestMutateRows_Retry_RstStream(t *testing.T) {
+	// 0. Common variables
+	const numRows int = 3
+	const tableID string = "table"
+	clientID := t.Name()
+
+	// 1. Instantiate the mock server, which is backed by a store. The first attempt will be reset
+	// before the results are sent.
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+	recorder := make(chan *mutateRowsReqRecord, 2)
+	server.MutateRowsFn = mockMutateRowsFnWithStore(server.store, recorder)
+	server.injectTransportFaults(t, &transportFault{
+		kind:    rstStream,
+		method:  "MutateRows",
+		attempt: 1,
+		errCode: http2.ErrCodeInternal,
+	})
+
+	// 2. Build the requests to test proxy
+	mutateReq := &testproxypb.MutateRowsRequest{
+		ClientId: clientID,
+		Request:  dummyMutateRowsRequest(tableID, numRows),
+	}
+	readReq := &testproxypb.ReadRowsRequest{
+		ClientId: clientID,
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName(tableID)},
+	}
+
+	// 3. Perform the operations via test proxy
+	setUp(t, server, clientID, nil)
+	defer tearDown(t, server, clientID)
+
+	mutateRes := doMutateRowsOpsCore(t, clientID, []*testproxypb.MutateRowsRequest{mutateReq}, nil)
+	readRes := doReadRowsOpsCore(t, clientID, []*testproxypb.ReadRowsRequest{readReq}, nil)
+
+	// 4a. Check that the write succeeded, and the retry had all the rows
+	checkResultOkStatus(t, mutateRes...)
+	assert.Equal(t, 2, len(recorder))
+	<-recorder
+	retryReq := <-recorder
+	assert.Equal(t, numRows, len(retryReq.req.GetEntries()))
+
+	// 4b. Check that all the rows were written
+	checkResultOkStatus(t, readRes...)
+	assert.Equal(t, numRows, len(readRes[0].GetRows()))
+}
+
+// TestMutateRows_Generic_SecureConnection tests that client can mutate rows on a TLS-enabled server.
+func TestMutateRows_Generic_SecureConnection(t *testing.T) {
+	// 0. Common variables
+	const numRows int = 2
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *mutateRowsReqRecord, 1)
+	action := &mutateRowsAction{data: buildEntryData([]int{0, 1}, nil, 0)}
+	server := initMockServerWithTLS(t, nil)
+	server.MutateRowsFn = mockMutateRowsFnSimple(recorder, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.MutateRowsRequest{
+		ClientId: t.Name(),
+		Request:  dummyMutateRowsRequest("table", numRows),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doMutateRowsOp(t, server, &req, tlsClientOpts(server, ""))
+
+	// 4. Check that the operation succeeded over the secure connection
+	checkResultOkStatus(t, res)
+	assert.Empty(t, res.GetEntries())
+	assert.Equal(t, 1, len(recorder))
+}
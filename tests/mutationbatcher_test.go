@@ -0,0 +1,483 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !emulator
+// +build !emulator
+
+package tests
+
+import (
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// batcherEntries returns the entries of a dummy MutateRowsRequest for the given rowkeys.
+func batcherEntries(rowKeys ...string) []*btpb.MutateRowsRequest_Entry {
+	return dummyMutateRowsRequestCore("table", rowKeys).GetEntries()
+}
+
+// batcherRowKeys returns `numRows` rowkeys of the same length, which are generated with the row
+// indices.
+func batcherRowKeys(numRows int) []string {
+	rowKeys := make([]string, numRows)
+	for i := range rowKeys {
+		rowKeys[i] = "row-" + strconv.Itoa(100+i)
+	}
+	return rowKeys
+}
+
+// batchSizes returns the sorted entry counts of the MutateRows `records`, i.e., the batches. The
+// batches may be in flight concurrently, so their order isn't checked.
+func batchSizes(records []*callRecord) []int {
+	sizes := make([]int, len(records))
+	for i, record := range records {
+		sizes[i] = len(record.req.(*btpb.MutateRowsRequest).GetEntries())
+	}
+	sort.Ints(sizes)
+	return sizes
+}
+
+// batchRowKeys returns the sorted rowkeys of all the entries in the MutateRows `records`.
+func batchRowKeys(records []*callRecord) []string {
+	var rowKeys []string
+	for _, record := range records {
+		for _, entry := range record.req.(*btpb.MutateRowsRequest).GetEntries() {
+			rowKeys = append(rowKeys, string(entry.GetRowKey()))
+		}
+	}
+	sort.Strings(rowKeys)
+	return rowKeys
+}
+
+// maxConcurrentCalls returns the maximum number of `records` in progress at the start of any of
+// them. The server records the end of a call after the client receives the response, so a call
+// that ends within `slack` after another starts doesn't count as concurrent.
+func maxConcurrentCalls(records []*callRecord, slack time.Duration) int {
+	most := 0
+	for _, record := range records {
+		n := 0
+		for _, other := range records {
+			if !other.start.After(record.start) && (other.end.IsZero() || other.end.After(record.start.Add(slack))) {
+				n++
+			}
+		}
+		most = max(most, n)
+	}
+	return most
+}
+
+// callRate returns the number of `records` per second that start within [from, to).
+func callRate(records []*callRecord, from time.Time, to time.Time) float64 {
+	n := 0
+	for _, record := range records {
+		if !record.start.Before(from) && record.start.Before(to) {
+			n++
+		}
+	}
+	return float64(n) / to.Sub(from).Seconds()
+}
+
+// runBatcherWorkload adds entries one at a time to the batcher with ID `batcherID` of the client
+// with ID `clientID`, at most `rate` entries per second, until `stop` is closed. Adding an entry
+// waits for the flow control of the batcher, so the client can slow the workload down. It returns
+// the number of entries added, and stops on the first entry that the batcher rejects.
+func runBatcherWorkload(t *testing.T, clientID string, batcherID string, rate float64, stop <-chan struct{}) int {
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer ticker.Stop()
+	for n := 0; ; n++ {
+		select {
+		case <-stop:
+			return n
+		case <-ticker.C:
+		}
+		res := addBatcherEntries(t, clientID, batcherID, batcherEntries("row-"+strconv.Itoa(n)))
+		if res == nil || res.GetStatus().GetCode() != int32(codes.OK) {
+			t.Errorf("Adding entry %d of the workload failed: %v", n, res.GetStatus())
+			return n
+		}
+	}
+}
+
+// TestMutationBatcher_Generic_BatchEntryLimit tests that the batcher sends a batch once it has the
+// maximum number of entries, and the remaining entries on close.
+func TestMutationBatcher_Generic_BatchEntryLimit(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	rowKeys := batcherRowKeys(7)
+
+	// 1. Instantiate the mock server
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+
+	// 2. Build the requests to test proxy
+	req := testproxypb.CreateBatcherRequest{
+		ClientId:  t.Name(),
+		BatcherId: "batcher",
+		TableName: buildTableName(tableID),
+		Settings: &testproxypb.BatcherSettings{
+			MaxBatchEntries: 3,
+			FlushInterval:   durationpb.New(time.Minute),
+		},
+	}
+
+	// 3. Perform the operations via test proxy
+	addResults, closeResult := doBatcherOps(t, server, &req, [][]*btpb.MutateRowsRequest_Entry{batcherEntries(rowKeys...)}, nil)
+
+	// 4a. Check that the entries are added and mutated successfully
+	checkResultOkStatus(t, addResults...)
+	checkResultOkStatus(t, closeResult)
+	assert.Empty(t, closeResult.GetEntries())
+
+	// 4b. Check that the server receives two full batches and the remaining entry
+	records := server.callRecords("MutateRows", "")
+	assert.Equal(t, []int{1, 3, 3}, batchSizes(records))
+	assert.Equal(t, rowKeys, batchRowKeys(records))
+}
+
+// TestMutationBatcher_Generic_BatchByteLimit tests that the batcher sends a batch once its entries
+// reach the maximum number of bytes.
+func TestMutationBatcher_Generic_BatchByteLimit(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	rowKeys := batcherRowKeys(7)
+	entries := batcherEntries(rowKeys...)
+	entrySize := proto.Size(entries[0])
+	maxBatchBytes := 2*entrySize + entrySize/2 // A batch reaches the limit with its third entry.
+
+	// 1. Instantiate the mock server
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+
+	// 2. Build the requests to test proxy
+	req := testproxypb.CreateBatcherRequest{
+		ClientId:  t.Name(),
+		BatcherId: "batcher",
+		TableName: buildTableName(tableID),
+		Settings: &testproxypb.BatcherSettings{
+			MaxBatchEntries: 100,
+			MaxBatchBytes:   int64(maxBatchBytes),
+			FlushInterval:   durationpb.New(time.Minute),
+		},
+	}
+
+	// 3. Perform the operations via test proxy
+	addResults, closeResult := doBatcherOps(t, server, &req, [][]*btpb.MutateRowsRequest_Entry{entries}, nil)
+
+	// 4a. Check that the entries are added and mutated successfully
+	checkResultOkStatus(t, addResults...)
+	checkResultOkStatus(t, closeResult)
+	assert.Empty(t, closeResult.GetEntries())
+
+	// 4b. Check that no batch is held back after reaching the limit
+	records := server.callRecords("MutateRows", "")
+	for i, record := range records {
+		batch := record.req.(*btpb.MutateRowsRequest).GetEntries()
+		size := 0
+		for _, entry := range batch[:len(batch)-1] {
+			size += proto.Size(entry)
+		}
+		if size >= maxBatchBytes {
+			t.Errorf("Batch %d has %d bytes before its last entry, want less than %d", i+1, size, maxBatchBytes)
+		}
+	}
+	assert.Equal(t, []int{1, 3, 3}, batchSizes(records))
+	assert.Equal(t, rowKeys, batchRowKeys(records))
+}
+
+// TestMutationBatcher_Generic_FlushInterval tests that the batcher sends a batch below the limits
+// once the flush interval passes, without a flush.
+func TestMutationBatcher_Generic_FlushInterval(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	const flushInterval = 300 * time.Millisecond
+	clientID := t.Name()
+	rowKeys := batcherRowKeys(2)
+
+	// 1. Instantiate the mock server
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.CreateBatcherRequest{
+		ClientId:  clientID,
+		BatcherId: "batcher",
+		TableName: buildTableName(tableID),
+		Settings: &testproxypb.BatcherSettings{
+			MaxBatchEntries: 100,
+			FlushInterval:   durationpb.New(flushInterval),
+		},
+	}
+
+	// 3. Perform the operations via test proxy
+	setUp(t, server, clientID, nil)
+	defer tearDown(t, server, clientID)
+
+	createBatcher(t, &req)
+	added := time.Now()
+	checkResultOkStatus(t, addBatcherEntries(t, clientID, req.GetBatcherId(), batcherEntries(rowKeys...)))
+	records := requireCallRecords(t, server, "MutateRows", "", 1)
+
+	// 4a. Check that the batch is sent after the flush interval, rather than held until the batcher
+	// is closed. The upper bound is loose, as the clients schedule the flush differently.
+	elapsed := records[0].start.Sub(added)
+	if elapsed < flushInterval || elapsed > 10*flushInterval {
+		t.Errorf("The batch is sent %v after the entries are added, want after %v and within %v", elapsed, flushInterval, 10*flushInterval)
+	}
+	assert.Equal(t, []int{2}, batchSizes(records))
+
+	// 4b. Check that closing the batcher sends nothing more
+	closeResult := closeBatcher(t, clientID, req.GetBatcherId())
+	checkResultOkStatus(t, closeResult)
+	assert.Empty(t, closeResult.GetEntries())
+	assert.Len(t, server.callRecords("MutateRows", ""), 1)
+}
+
+// testBatcherFlowControl adds `numRows` entries in single-entry batches to a batcher with the flow
+// control `settings`, which let `maxOutstanding` entries in at a time, and checks that the
+// batches in flight never exceed the limit.
+func testBatcherFlowControl(t *testing.T, settings *testproxypb.BatcherSettings, maxOutstanding int, numRows int) {
+	// 0. Common variables
+	const tableID string = "table"
+	const delay = 300 * time.Millisecond
+	clientID := t.Name()
+	rowKeys := batcherRowKeys(numRows)
+
+	// 1. Instantiate the mock server
+	// Every batch takes a while, so that the outstanding entries hold back the next ones.
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+	actions := make([]*mutateRowsAction, numRows)
+	for i := range actions {
+		actions[i] = &mutateRowsAction{delayStr: delay.String()}
+	}
+	server.MutateRowsFn = mockMutateRowsFnWithStore(server.store, nil, actions)
+
+	// 2. Build the request to test proxy
+	settings.MaxBatchEntries = 1
+	settings.FlushInterval = durationpb.New(time.Minute)
+	req := testproxypb.CreateBatcherRequest{
+		ClientId:  clientID,
+		BatcherId: "batcher",
+		TableName: buildTableName(tableID),
+		Settings:  settings,
+	}
+
+	// 3. Perform the operations via test proxy
+	setUp(t, server, clientID, nil)
+	defer tearDown(t, server, clientID)
+
+	createBatcher(t, &req)
+	start := time.Now()
+	checkResultOkStatus(t, addBatcherEntries(t, clientID, req.GetBatcherId(), batcherEntries(rowKeys...)))
+	addDuration := time.Since(start)
+	closeResult := closeBatcher(t, clientID, req.GetBatcherId())
+	checkResultOkStatus(t, closeResult)
+	assert.Empty(t, closeResult.GetEntries())
+
+	// 4a. Check that adding the entries waits for the batches in flight
+	rounds := (numRows + maxOutstanding - 1) / maxOutstanding
+	if minDuration := time.Duration(rounds-1) * delay; addDuration < minDuration/2 {
+		t.Errorf("Adding %d entries takes %v, want at least %v of flow control", numRows, addDuration, minDuration)
+	}
+
+	// 4b. Check that the batches in flight never exceed the limit
+	records := server.callRecords("MutateRows", "")
+	if n := maxConcurrentCalls(records, delay/2); n > maxOutstanding {
+		t.Errorf("%d batches are in flight at the same time, want at most %d", n, maxOutstanding)
+	}
+	assert.Equal(t, rowKeys, batchRowKeys(records))
+}
+
+// TestMutationBatcher_Generic_FlowControlEntries tests that adding entries waits while the
+// maximum number of entries is outstanding.
+func TestMutationBatcher_Generic_FlowControlEntries(t *testing.T) {
+	testBatcherFlowControl(t, &testproxypb.BatcherSettings{MaxOutstandingEntries: 2}, 2, 6)
+}
+
+// TestMutationBatcher_Generic_FlowControlBytes tests that adding entries waits while the
+// outstanding entries have the maximum number of bytes.
+func TestMutationBatcher_Generic_FlowControlBytes(t *testing.T) {
+	entrySize := proto.Size(batcherEntries(batcherRowKeys(1)...)[0])
+	settings := &testproxypb.BatcherSettings{MaxOutstandingBytes: int64(2*entrySize + entrySize/2)}
+	testBatcherFlowControl(t, settings, 2, 6)
+}
+
+// TestMutationBatcher_NoRetry_PartialFailuresAcrossBatches tests that the failed entries of
+// different batches are reported with their indexes among all the added entries, and that a
+// closed batcher rejects new entries.
+func TestMutationBatcher_NoRetry_PartialFailuresAcrossBatches(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	clientID := t.Name()
+	rowKeys := []string{"op0-row-a", "op0-row-b", "op1-row-a", "op1-row-b"}
+
+	// 1. Instantiate the mock server
+	// Each batch of two entries fails one of them with a non-retryable error.
+	server := initMockServer(t)
+	server.MutateRowsFn = mockMutateRowsFn(nil,
+		[]*mutateRowsAction{{data: buildEntryData([]int{0}, []int{1}, codes.PermissionDenied), endOfStream: true}},
+		[]*mutateRowsAction{{data: buildEntryData([]int{1}, []int{0}, codes.InvalidArgument), endOfStream: true}},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.CreateBatcherRequest{
+		ClientId:  clientID,
+		BatcherId: "batcher",
+		TableName: buildTableName(tableID),
+		Settings: &testproxypb.BatcherSettings{
+			MaxBatchEntries: 2,
+			FlushInterval:   durationpb.New(time.Minute),
+		},
+	}
+
+	// 3. Perform the operations via test proxy
+	setUp(t, server, clientID, nil)
+	defer tearDown(t, server, clientID)
+
+	createBatcher(t, &req)
+	checkResultOkStatus(t, addBatcherEntries(t, clientID, req.GetBatcherId(), batcherEntries(rowKeys...)))
+	flushResult := flushBatcher(t, clientID, req.GetBatcherId())
+	closeResult := closeBatcher(t, clientID, req.GetBatcherId())
+	addResult := addBatcherEntries(t, clientID, req.GetBatcherId(), batcherEntries("op0-row-c"))
+
+	// 4a. Check that the server receives the two batches without retries
+	records := server.callRecords("MutateRows", "")
+	assert.Equal(t, []int{2, 2}, batchSizes(records))
+
+	// 4b. Check that the flush reports the failed entry of each batch
+	checkResultOkStatus(t, flushResult)
+	failures := make(map[int64]codes.Code)
+	for _, entry := range flushResult.GetEntries() {
+		failures[entry.GetIndex()] = codes.Code(entry.GetStatus().GetCode())
+	}
+	assert.Equal(t, map[int64]codes.Code{1: codes.PermissionDenied, 2: codes.InvalidArgument}, failures)
+
+	// 4c. Check that closing the batcher doesn't report them again
+	checkResultOkStatus(t, closeResult)
+	assert.Empty(t, closeResult.GetEntries())
+
+	// 4d. Check that the closed batcher rejects new entries
+	if addResult != nil {
+		assert.NotEqual(t, int32(codes.OK), addResult.GetStatus().GetCode())
+	}
+	assert.Len(t, server.callRecords("MutateRows", ""), 2)
+}
+
+// TestMutationBatcher_Generic_RateLimitAdapts tests that a long-running bulk write workload adapts
+// its rate of MutateRows to the RateLimitInfo in the responses: the rate goes down while the server
+// returns a factor below 1, and up again while the factor is above 1. The test only applies to the
+// clients that advertise the MutateRows rate limit in the feature flags.
+func TestMutationBatcher_Generic_RateLimitAdapts(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	const offeredRate = 50.0 // Entries per second, which is also MutateRows calls per second.
+	const baselinePhase = 2 * time.Second
+	const downPhase = 3 * time.Second
+	const upPhase = 5 * time.Second
+	const period = time.Second
+	clientID := t.Name()
+
+	// 1. Instantiate the mock server
+	// The server doesn't limit the rate at first, and changes the factor in the later phases.
+	var mu sync.Mutex
+	var rateLimitInfo *btpb.RateLimitInfo
+	setFactor := func(factor float64) {
+		mu.Lock()
+		defer mu.Unlock()
+		rateLimitInfo = &btpb.RateLimitInfo{Period: durationpb.New(period), Factor: factor}
+	}
+	server := initMockServer(t)
+	server.MutateRowsFn = mockMutateRowsFnWithRateLimit(nil, func() *btpb.RateLimitInfo {
+		mu.Lock()
+		defer mu.Unlock()
+		return rateLimitInfo
+	})
+
+	// 2. Build the request to test proxy
+	// Every entry is a batch of its own, and the outstanding entries are few, so that the entries
+	// held back by the client don't delay the effect of a new rate.
+	req := testproxypb.CreateBatcherRequest{
+		ClientId:  clientID,
+		BatcherId: "batcher",
+		TableName: buildTableName(tableID),
+		Settings: &testproxypb.BatcherSettings{
+			MaxBatchEntries:       1,
+			MaxOutstandingEntries: 2,
+			FlushInterval:         durationpb.New(time.Minute),
+		},
+	}
+
+	// 3. Perform the workload via test proxy
+	setUp(t, server, clientID, nil)
+	defer tearDown(t, server, clientID)
+
+	createBatcher(t, &req)
+	stop := make(chan struct{})
+	added := make(chan int)
+	go func() {
+		added <- runBatcherWorkload(t, clientID, req.GetBatcherId(), offeredRate, stop)
+	}()
+	var stopOnce sync.Once
+	stopWorkload := func() {
+		stopOnce.Do(func() {
+			close(stop)
+			t.Logf("The workload added %d entries", <-added)
+			checkResultOkStatus(t, closeBatcher(t, clientID, req.GetBatcherId()))
+		})
+	}
+	// The workload must not outlive the test, even if the test fails before stopping it.
+	t.Cleanup(stopWorkload)
+
+	start := time.Now()
+	time.Sleep(baselinePhase)
+	ff, err := getClientFeatureFlags(requireCallRecords(t, server, "MutateRows", "", 1)[0].md)
+	if err != nil || !(ff.GetMutateRowsRateLimit() || ff.GetMutateRowsRateLimit2()) {
+		stopWorkload()
+		if *enableFeaturesAll {
+			t.Fatalf("The client doesn't advertise the MutateRows rate limit in the feature flags: %v", err)
+		}
+		t.Skip("The client doesn't advertise the MutateRows rate limit, which is only required with --enable_features_all")
+	}
+	down := time.Now()
+	setFactor(0.5)
+	time.Sleep(downPhase)
+	up := time.Now()
+	setFactor(1.5)
+	time.Sleep(upPhase)
+	end := time.Now()
+	stopWorkload()
+
+	// 4. Check that the rate goes down with the factor below 1, and up with the factor above 1. The
+	// clients may cap the factors, e.g., to [0.7, 1.3], so the rates are compared over two periods,
+	// with bounds loose enough for the capped factors.
+	records := server.callRecords("MutateRows", "")
+	baselineRate := callRate(records, start, down)
+	downStartRate := callRate(records, down, down.Add(period))
+	downEndRate := callRate(records, up.Add(-2*period), up)
+	upEndRate := callRate(records, end.Add(-2*period), end)
+	t.Logf("MutateRows per second: %.1f without rate limit, %.1f then %.1f with factor 0.5, %.1f with factor 1.5",
+		baselineRate, downStartRate, downEndRate, upEndRate)
+	if downEndRate >= baselineRate*0.9 {
+		t.Errorf("The rate doesn't go down with factor 0.5: %.1f at first, %.1f at last, %.1f without rate limit", downStartRate, downEndRate, baselineRate)
+	}
+	if upEndRate <= downEndRate*1.1 {
+		t.Errorf("The rate doesn't go up with factor 1.5: %.1f, after %.1f with factor 0.5", upEndRate, downEndRate)
+	}
+}
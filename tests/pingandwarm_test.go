@@ -0,0 +1,182 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !emulator
+// +build !emulator
+
+package tests
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+)
+
+// primingWaitTime is how long the tests wait for the client to prime its channels after client
+// creation, as priming may happen in the background.
+const primingWaitTime = 2 * time.Second
+
+// TestPingAndWarm_Generic_PrimingAtCreateClient tests that client primes its channels at client
+// creation, using the configured instance name and app profile.
+func TestPingAndWarm_Generic_PrimingAtCreateClient(t *testing.T) {
+	// 0. Common variables
+	const profileID string = "test_profile"
+	clientID := t.Name()
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.PingAndWarmFn = mockPingAndWarmFn(nil)
+
+	// 2. Create the client via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	setUp(t, server, clientID, &opts)
+	defer tearDown(t, server, clientID)
+
+	// 3. Wait for the priming request
+	records, err := server.waitForCallRecords("PingAndWarm", "", 1, primingWaitTime)
+	if err != nil {
+		assert.Fail(t, "No PingAndWarm request is received after client creation")
+		return
+	}
+	req := records[0].req.(*btpb.PingAndWarmRequest)
+
+	// 4. Check the priming request uses the client settings
+	assert.Equal(t, instanceName, req.GetName())
+	assert.Equal(t, profileID, req.GetAppProfileId())
+}
+
+// TestPingAndWarm_Generic_Headers tests that PingAndWarm request has client and resource info, as
+// well as app_profile_id in the header.
+func TestPingAndWarm_Generic_Headers(t *testing.T) {
+	// 0. Common variables
+	const profileID string = "test_profile"
+	clientID := t.Name()
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.PingAndWarmFn = mockPingAndWarmFn(nil)
+
+	// 2. Create the client via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	setUp(t, server, clientID, &opts)
+	defer tearDown(t, server, clientID)
+
+	// 3. Wait for the priming request
+	records, err := server.waitForCallRecords("PingAndWarm", "", 1, primingWaitTime)
+	if err != nil {
+		assert.Fail(t, "No PingAndWarm request is received after client creation")
+		return
+	}
+	md := records[0].md
+
+	// 4. Check the request headers in the metadata
+	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
+		assert.Fail(t, "Client info is missing in the request header")
+	}
+
+	if !assert.NotEmpty(t, md["x-goog-request-params"], "Resource info is missing in the request header") {
+		return
+	}
+	resource := md["x-goog-request-params"][0]
+	if !strings.Contains(resource, instanceName) && !strings.Contains(resource, url.QueryEscape(instanceName)) {
+		assert.Fail(t, "Resource info is missing in the request header")
+	}
+	assert.Contains(t, resource, profileID)
+}
+
+// TestPingAndWarm_NoRetry_FailedPrimingDoesNotFailCreateClient tests that client creation succeeds
+// and the client stays usable when priming fails.
+func TestPingAndWarm_NoRetry_FailedPrimingDoesNotFailCreateClient(t *testing.T) {
+	// 0. Common variables
+	const rowKey string = "row-01"
+	clientID := t.Name()
+
+	// 1. Instantiate the mock server
+	pingRecorder := make(chan *pingAndWarmReqRecord, 10)
+	actions := make([]*pingAndWarmAction, 10)
+	for i := range actions {
+		actions[i] = &pingAndWarmAction{rpcError: codes.Unavailable}
+	}
+	server := initMockServer(t)
+	server.PingAndWarmFn = mockPingAndWarmFn(pingRecorder, actions...)
+	server.ReadRowsFn = mockReadRowsFnSimple(nil, &readRowsAction{
+		chunks: []chunkData{dummyChunkData(rowKey, "v1", Commit)},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowRequest{
+		ClientId:  clientID,
+		TableName: buildTableName("table"),
+		RowKey:    rowKey,
+	}
+
+	// 3. Create the client and perform the operation via test proxy
+	setUp(t, server, clientID, nil)
+	defer tearDown(t, server, clientID)
+	res := doReadRowOpsCore(t, clientID, []*testproxypb.ReadRowRequest{&req}, nil)[0]
+
+	// 4. Check that the operation succeeded regardless of the priming failure
+	checkResultOkStatus(t, res)
+	assert.Equal(t, rowKey, string(res.GetRow().GetKey()))
+}
+
+// TestPingAndWarm_NoRetry_SlowPrimingDoesNotBlockCreateClient tests that client creation doesn't
+// wait for priming that never completes.
+func TestPingAndWarm_NoRetry_SlowPrimingDoesNotBlockCreateClient(t *testing.T) {
+	// 0. Common variables
+	const rowKey string = "row-01"
+	clientID := t.Name()
+
+	// 1. Instantiate the mock server
+	actions := make([]*pingAndWarmAction, 10)
+	for i := range actions {
+		actions[i] = &pingAndWarmAction{delayStr: "10s"}
+	}
+	server := initMockServer(t)
+	server.PingAndWarmFn = mockPingAndWarmFn(nil, actions...)
+	server.ReadRowsFn = mockReadRowsFnSimple(nil, &readRowsAction{
+		chunks: []chunkData{dummyChunkData(rowKey, "v1", Commit)},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowRequest{
+		ClientId:  clientID,
+		TableName: buildTableName("table"),
+		RowKey:    rowKey,
+	}
+
+	// 3. Create the client and perform the operation via test proxy
+	start := time.Now()
+	setUp(t, server, clientID, nil)
+	creationTime := time.Since(start)
+	defer tearDown(t, server, clientID)
+	res := doReadRowOpsCore(t, clientID, []*testproxypb.ReadRowRequest{&req}, nil)[0]
+
+	// 4a. Check the runtime of client creation
+	assert.Less(t, creationTime, 5*time.Second) // 5s (< 10s of server delay time) indicates no blocking.
+
+	// 4b. Check that the operation succeeded
+	checkResultOkStatus(t, res)
+	assert.Equal(t, rowKey, string(res.GetRow().GetKey()))
+}
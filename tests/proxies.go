@@ -0,0 +1,112 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the named test proxies of -proxy_addr, so that one run can
+// compare several clients. The Go tests run once per proxy, in a pass of their
+// own, while TestScenarios runs each scenario against every proxy in the first
+// pass and diffs the behaviors of the clients, see scenario_diff.go.
+package tests
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+)
+
+// testProxy is a test proxy of -proxy_addr.
+type testProxy struct {
+	name   string // The name in the flag, or the address if the proxy isn't named.
+	addr   string
+	client testproxypb.CloudBigtableV2TestProxyClient // Set once the proxy is connected.
+}
+
+// proxies are the test proxies of -proxy_addr in the flag order.
+var proxies []*testProxy
+
+// passProxy is the index in `proxies` of the test proxy that the current pass of the tests runs
+// against, see TestMain.
+var passProxy int
+
+// multiProxyName returns the name of the test proxy that the test `t` runs against, or "" if
+// there is a single test proxy.
+func multiProxyName(t *testing.T) string {
+	if len(proxies) <= 1 {
+		return ""
+	}
+	testProxiesMu.Lock()
+	defer testProxiesMu.Unlock()
+	if p, ok := testProxies[t]; ok {
+		return p.name
+	}
+	return proxies[passProxy].name
+}
+
+// parseProxyAddrs parses -proxy_addr, which is either a single address, or a comma-separated list
+// of named addresses, e.g., "java=:9999,go=:9998".
+func parseProxyAddrs(flagValue string) ([]*testProxy, error) {
+	var result []*testProxy
+	names := make(map[string]bool)
+	for _, item := range strings.Split(flagValue, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		name, addr, named := strings.Cut(item, "=")
+		if !named {
+			name, addr = item, item
+		}
+		if name == "" || addr == "" {
+			return nil, fmt.Errorf("invalid proxy %q, want name=host:port", item)
+		}
+		if names[name] {
+			return nil, fmt.Errorf("duplicate proxy name %q", name)
+		}
+		names[name] = true
+		result = append(result, &testProxy{name: name, addr: addr})
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no proxy address in %q", flagValue)
+	}
+	return result, nil
+}
+
+var (
+	testProxiesMu sync.Mutex
+	testProxies   = make(map[*testing.T]*testProxy) // The tests that don't run against the first proxy.
+)
+
+// useProxy makes the test `t` run against the test proxy `p` instead of the one of the pass.
+func useProxy(t *testing.T, p *testProxy) {
+	testProxiesMu.Lock()
+	testProxies[t] = p
+	testProxiesMu.Unlock()
+	t.Cleanup(func() {
+		testProxiesMu.Lock()
+		delete(testProxies, t)
+		testProxiesMu.Unlock()
+	})
+}
+
+// proxyClient returns the stub of the test proxy that the test `t` runs against.
+func proxyClient(t *testing.T) testproxypb.CloudBigtableV2TestProxyClient {
+	testProxiesMu.Lock()
+	defer testProxiesMu.Unlock()
+	if p, ok := testProxies[t]; ok {
+		return p.client
+	}
+	return testProxyClient
+}
@@ -0,0 +1,288 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the launcher of the test proxy for the -proxy_cmd mode,
+// where the tests start the proxy as a subprocess instead of connecting to a
+// running one. The launcher captures the output of the proxy into per-test
+// logs, and restarts the proxy if it crashes, in which case the affected test
+// fails as a proxy failure rather than a client failure.
+package tests
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// proxyReadyTimeout is how long to wait for the test proxy to accept connections after it starts.
+const proxyReadyTimeout = 100 * time.Second
+
+// proxyOutputDelay is how long to wait for the output of the test proxy after it exits. The
+// output may be held open by the processes it leaves behind, until they are killed.
+const proxyOutputDelay = 2 * time.Second
+
+// waitForProxy waits until `addr` accepts TCP connections, or until `timeout` elapses or `exited`
+// is closed.
+func waitForProxy(addr string, timeout time.Duration, exited <-chan struct{}) error {
+	deadline := time.Now().Add(timeout)
+	for {
+		conn, err := net.Dial("tcp", addr)
+		if err == nil {
+			conn.Close()
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("test proxy is not available at %s after %v", addr, timeout)
+		}
+		log.Println("Test Proxy is not ready, waiting...")
+		select {
+		case <-exited:
+			return fmt.Errorf("test proxy exited before accepting connections")
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// freePort returns a TCP port that is free on the local host.
+func freePort() (string, error) {
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return "", err
+	}
+	defer lis.Close()
+	_, port, err := net.SplitHostPort(lis.Addr().String())
+	return port, err
+}
+
+// proxyOutput is the combined stdout and stderr of the test proxy. It's safe for concurrent use.
+type proxyOutput struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (o *proxyOutput) Write(p []byte) (int, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf.Write(p)
+}
+
+// offset returns the size of the output so far.
+func (o *proxyOutput) offset() int {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.buf.Len()
+}
+
+// since returns a copy of the output after `offset`.
+func (o *proxyOutput) since(offset int) string {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return string(o.buf.Bytes()[offset:])
+}
+
+// proxyLauncher runs the test proxy as a subprocess.
+type proxyLauncher struct {
+	command string // The command line, where "{port}" is replaced by the port of the proxy.
+	addr    string // The address of the proxy, which is kept across restarts.
+	logDir  string // The directory of the per-test logs, "" means no logs are written.
+	output  proxyOutput
+
+	mu      sync.Mutex
+	cmd     *exec.Cmd
+	exited  chan struct{} // Closed when the current process exits.
+	exitErr error         // The exit status of the current process, valid after `exited` is closed.
+
+	watchedMu sync.Mutex
+	watched   map[*testing.T]bool
+}
+
+// launcher is the launcher of the test proxy in the -proxy_cmd mode, nil otherwise.
+var launcher *proxyLauncher
+
+// newProxyLauncher returns a launcher of `command`. The proxy will listen on the port of `addr`, or
+// on a free port if `addr` is "".
+func newProxyLauncher(command string, addr string, logDir string) (*proxyLauncher, error) {
+	port := ""
+	if addr != "" {
+		var err error
+		if _, port, err = net.SplitHostPort(addr); err != nil {
+			return nil, fmt.Errorf("invalid proxy address %q: %v", addr, err)
+		}
+	} else {
+		var err error
+		if port, err = freePort(); err != nil {
+			return nil, fmt.Errorf("no free port for the test proxy: %v", err)
+		}
+	}
+	if logDir != "" {
+		if err := os.MkdirAll(logDir, 0755); err != nil {
+			return nil, err
+		}
+	}
+	return &proxyLauncher{
+		command: strings.ReplaceAll(command, "{port}", port),
+		addr:    net.JoinHostPort("localhost", port),
+		logDir:  logDir,
+		watched: make(map[*testing.T]bool),
+	}, nil
+}
+
+// start starts the test proxy and waits for it to accept connections.
+func (l *proxyLauncher) start() error {
+	_, port, _ := net.SplitHostPort(l.addr)
+	cmd := proxyCommand(l.command)
+	cmd.Env = append(os.Environ(), "PROXY_PORT="+port)
+	cmd.Stdout = &l.output
+	cmd.Stderr = &l.output
+	cmd.WaitDelay = proxyOutputDelay
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start the test proxy: %v", err)
+	}
+	log.Printf("Started the test proxy (pid %d): %s", cmd.Process.Pid, l.command)
+
+	exited := make(chan struct{})
+	l.mu.Lock()
+	l.cmd = cmd
+	l.exited = exited
+	l.mu.Unlock()
+	go func() {
+		err := cmd.Wait()
+		// A crashed proxy may leave processes behind, which would keep its port.
+		killProxy(cmd)
+		l.mu.Lock()
+		l.exitErr = err
+		l.mu.Unlock()
+		close(exited)
+	}()
+
+	if err := waitForProxy(l.addr, proxyReadyTimeout, exited); err != nil {
+		l.stop()
+		return fmt.Errorf("%v, output:\n%s", err, l.output.since(0))
+	}
+	return nil
+}
+
+// stop kills the test proxy, together with the processes it started, and waits for it to exit.
+func (l *proxyLauncher) stop() {
+	l.mu.Lock()
+	cmd, exited := l.cmd, l.exited
+	l.mu.Unlock()
+	if cmd == nil {
+		return
+	}
+	killProxy(cmd)
+	<-exited
+}
+
+// running returns the channel closed on the exit of the current process, after restarting the
+// test proxy if it has exited.
+func (l *proxyLauncher) running() (<-chan struct{}, error) {
+	l.mu.Lock()
+	exited := l.exited
+	l.mu.Unlock()
+	select {
+	case <-exited:
+		if err := l.start(); err != nil {
+			return nil, err
+		}
+		l.mu.Lock()
+		defer l.mu.Unlock()
+		return l.exited, nil
+	default:
+		return exited, nil
+	}
+}
+
+// exitStatus returns the exit status of the last process.
+func (l *proxyLauncher) exitStatus() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.exitErr
+}
+
+// watch captures the output of the test proxy during the test `t` into the per-test log, and
+// checks that the proxy doesn't crash during the test. If it does, the test fails as a proxy
+// failure and the proxy is restarted for the next tests. watch is idempotent within a test.
+func (l *proxyLauncher) watch(t *testing.T) {
+	l.watchedMu.Lock()
+	if l.watched[t] {
+		l.watchedMu.Unlock()
+		return
+	}
+	l.watched[t] = true
+	l.watchedMu.Unlock()
+
+	exited, err := l.running()
+	if err != nil {
+		t.Fatalf("Proxy failure: failed to restart the test proxy: %v", err)
+	}
+	offset := l.output.offset()
+
+	t.Cleanup(func() {
+		l.watchedMu.Lock()
+		delete(l.watched, t)
+		l.watchedMu.Unlock()
+
+		logs := l.output.since(offset)
+		if l.logDir != "" {
+			file := filepath.Join(l.logDir, strings.ReplaceAll(t.Name(), "/", "_")+".log")
+			if err := os.WriteFile(file, []byte(logs), 0644); err != nil {
+				t.Logf("Failed to write the proxy log: %v", err)
+			}
+		}
+
+		// A crash may surface as a failed RPC before the exit of the process is noticed.
+		crashed := false
+		if t.Failed() {
+			select {
+			case <-exited:
+				crashed = true
+			case <-time.After(time.Second):
+			}
+		} else {
+			select {
+			case <-exited:
+				crashed = true
+			default:
+			}
+		}
+
+		if !crashed {
+			if t.Failed() && logs != "" {
+				t.Logf("Proxy output during the test:\n%s", logs)
+			}
+			return
+		}
+		t.Errorf("Proxy failure: the test proxy exited during the test (%v), so the result "+
+			"doesn't reflect the client. Proxy output during the test:\n%s", l.exitStatus(), logs)
+		if _, err := l.running(); err != nil {
+			t.Errorf("Proxy failure: failed to restart the test proxy: %v", err)
+		}
+	})
+}
+
+// watchProxy makes the launcher watch the test proxy during the test `t` in the -proxy_cmd mode.
+func watchProxy(t *testing.T) {
+	if launcher != nil {
+		launcher.watch(t)
+	}
+}
@@ -0,0 +1,483 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !emulator
+// +build !emulator
+
+package tests
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// dummyPartition returns a partition covering the row range [startKey, endKey).
+func dummyPartition(startKey string, endKey string) *btpb.StreamPartition {
+	return &btpb.StreamPartition{
+		RowRange: &btpb.RowRange{
+			StartKey: &btpb.RowRange_StartKeyClosed{StartKeyClosed: []byte(startKey)},
+			EndKey:   &btpb.RowRange_EndKeyOpen{EndKeyOpen: []byte(endKey)},
+		},
+	}
+}
+
+// dummyReadChangeStreamRequest returns a ReadChangeStreamRequest reading `partition` from now on.
+func dummyReadChangeStreamRequest(tableID string, partition *btpb.StreamPartition) *btpb.ReadChangeStreamRequest {
+	return &btpb.ReadChangeStreamRequest{
+		TableName: buildTableName(tableID),
+		Partition: partition,
+		StartFrom: &btpb.ReadChangeStreamRequest_StartTime{StartTime: timestamppb.Now()},
+	}
+}
+
+// heartbeatAction returns an action that sends a heartbeat with the continuation `token` of
+// `partition`.
+func heartbeatAction(partition *btpb.StreamPartition, token string) *readChangeStreamAction {
+	return &readChangeStreamAction{
+		heartbeat: &btpb.ReadChangeStreamResponse_Heartbeat{
+			ContinuationToken:     &btpb.StreamContinuationToken{Partition: partition, Token: token},
+			EstimatedLowWatermark: timestamppb.Now(),
+		},
+	}
+}
+
+// setCellChange returns a complete DataChange that sets `value` to the cell "f:col" of `rowKey`.
+func setCellChange(rowKey string, value string, token string) *btpb.ReadChangeStreamResponse_DataChange {
+	return &btpb.ReadChangeStreamResponse_DataChange{
+		Type:            btpb.ReadChangeStreamResponse_DataChange_USER,
+		SourceClusterId: "cluster",
+		RowKey:          []byte(rowKey),
+		CommitTimestamp: timestamppb.Now(),
+		Chunks: []*btpb.ReadChangeStreamResponse_MutationChunk{
+			{
+				Mutation: &btpb.Mutation{
+					Mutation: &btpb.Mutation_SetCell_{
+						SetCell: &btpb.Mutation_SetCell{
+							FamilyName:      "f",
+							ColumnQualifier: []byte("col"),
+							Value:           []byte(value),
+						},
+					},
+				},
+			},
+		},
+		Done:                  true,
+		Token:                 token,
+		EstimatedLowWatermark: timestamppb.Now(),
+	}
+}
+
+// TestReadChangeStream_Generic_Headers tests that ReadChangeStream request has client and resource
+// info, as well as app_profile_id in the header.
+func TestReadChangeStream_Generic_Headers(t *testing.T) {
+	// 0. Common variables
+	const profileID string = "test_profile"
+	tableName := buildTableName("table")
+	partition := dummyPartition("", "")
+
+	// 1. Instantiate the mock server
+	// The server records the metadata of every request, so the mock function only responds
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = func(req *btpb.ReadChangeStreamRequest, srv btpb.Bigtable_ReadChangeStreamServer) error {
+		return srv.Send(&btpb.ReadChangeStreamResponse{
+			StreamRecord: &btpb.ReadChangeStreamResponse_CloseStream_{
+				CloseStream: &btpb.ReadChangeStreamResponse_CloseStream{Status: &status.Status{}},
+			},
+		})
+	}
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", partition),
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	doReadChangeStreamOp(t, server, &req, &opts)
+
+	// 4. Check the request headers in the metadata
+	md := requireCallRecords(t, server, "ReadChangeStream", "", 1)[0].md
+	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
+		assert.Fail(t, "Client info is missing in the request header")
+	}
+
+	resource := md["x-goog-request-params"][0]
+	if !strings.Contains(resource, tableName) && !strings.Contains(resource, url.QueryEscape(tableName)) {
+		assert.Fail(t, "Resource info is missing in the request header")
+	}
+	assert.Contains(t, resource, profileID)
+}
+
+// TestReadChangeStream_Retry_Headers tests that every attempt of ReadChangeStream, including the retries,
+// has the client and resource info, an incrementing attempt counter and a stable operation ID.
+func TestReadChangeStream_Retry_Headers(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	const profileID string = "test_profile"
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = mockReadChangeStreamFnSimple(nil,
+		&readChangeStreamAction{rpcError: codes.Unavailable},
+		&readChangeStreamAction{rpcError: codes.Unavailable},
+		&readChangeStreamAction{closeStream: &btpb.ReadChangeStreamResponse_CloseStream{Status: &status.Status{}}},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", dummyPartition("", "")),
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	res := doReadChangeStreamOp(t, server, &req, &opts)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+
+	// 4b. Check the headers of every attempt
+	assert.Equal(t, numRPCs, checkAttemptHeaders(t, server, "ReadChangeStream", "", attemptHeaders{
+		params: map[string]string{"table_name": buildTableName("table"), "app_profile_id": profileID},
+	}))
+}
+
+// TestReadChangeStream_NoRetry_DataChangesAndHeartbeats tests that client returns the data changes
+// and heartbeats in the order they are received.
+func TestReadChangeStream_NoRetry_DataChangesAndHeartbeats(t *testing.T) {
+	// 0. Common variables
+	partition := dummyPartition("", "")
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *readChangeStreamReqRecord, 1)
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = mockReadChangeStreamFnSimple(recorder,
+		&readChangeStreamAction{dataChange: setCellChange("row-01", "v1", "token-01")},
+		heartbeatAction(partition, "token-02"),
+		&readChangeStreamAction{dataChange: setCellChange("row-02", "v2", "token-03")},
+		&readChangeStreamAction{closeStream: &btpb.ReadChangeStreamResponse_CloseStream{Status: &status.Status{}}},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", partition),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadChangeStreamOp(t, server, &req, nil)
+
+	// 4. Check that the operation succeeded, and the records are in order
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 1, len(recorder))
+	records := res.GetRecords()
+	if !assert.GreaterOrEqual(t, len(records), 3) {
+		return
+	}
+	assert.Equal(t, []byte("row-01"), records[0].GetMutation().GetRowKey())
+	assert.Equal(t, "token-01", records[0].GetMutation().GetToken())
+	assert.Equal(t, "token-02", records[1].GetHeartbeat().GetContinuationToken().GetToken())
+	assert.Equal(t, []byte("row-02"), records[2].GetMutation().GetRowKey())
+	assert.Equal(t, []byte("v2"), records[2].GetMutation().GetMutations()[0].GetSetCell().GetValue())
+}
+
+// TestReadChangeStream_NoRetry_ChunkedValue tests that client merges a cell value chunked across
+// several DataChange messages into one mutation.
+func TestReadChangeStream_NoRetry_ChunkedValue(t *testing.T) {
+	// 0. Common variables
+	const part1, part2 string = "chunked-", "value"
+	partition := dummyPartition("", "")
+	chunk := func(value string, offset int, last bool) *btpb.ReadChangeStreamResponse_MutationChunk {
+		return &btpb.ReadChangeStreamResponse_MutationChunk{
+			ChunkInfo: &btpb.ReadChangeStreamResponse_MutationChunk_ChunkInfo{
+				ChunkedValueSize:   int32(len(part1 + part2)),
+				ChunkedValueOffset: int32(offset),
+				LastChunk:          last,
+			},
+			Mutation: &btpb.Mutation{
+				Mutation: &btpb.Mutation_SetCell_{
+					SetCell: &btpb.Mutation_SetCell{
+						FamilyName:      "f",
+						ColumnQualifier: []byte("col"),
+						Value:           []byte(value),
+					},
+				},
+			},
+		}
+	}
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = mockReadChangeStreamFnSimple(nil,
+		&readChangeStreamAction{
+			dataChange: &btpb.ReadChangeStreamResponse_DataChange{
+				Type:            btpb.ReadChangeStreamResponse_DataChange_USER,
+				SourceClusterId: "cluster",
+				RowKey:          []byte("row-01"),
+				CommitTimestamp: timestamppb.Now(),
+				Chunks:          []*btpb.ReadChangeStreamResponse_MutationChunk{chunk(part1, 0, false)},
+			},
+		},
+		&readChangeStreamAction{
+			dataChange: &btpb.ReadChangeStreamResponse_DataChange{
+				Type:                  btpb.ReadChangeStreamResponse_DataChange_CONTINUATION,
+				Chunks:                []*btpb.ReadChangeStreamResponse_MutationChunk{chunk(part2, len(part1), true)},
+				Done:                  true,
+				Token:                 "token-01",
+				EstimatedLowWatermark: timestamppb.Now(),
+			},
+		},
+		&readChangeStreamAction{closeStream: &btpb.ReadChangeStreamResponse_CloseStream{Status: &status.Status{}}},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", partition),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadChangeStreamOp(t, server, &req, nil)
+
+	// 4. Check that the chunks are merged into one mutation
+	checkResultOkStatus(t, res)
+	if !assert.GreaterOrEqual(t, len(res.GetRecords()), 1) {
+		return
+	}
+	mutation := res.GetRecords()[0].GetMutation()
+	assert.Equal(t, []byte("row-01"), mutation.GetRowKey())
+	assert.Equal(t, "token-01", mutation.GetToken())
+	if assert.Equal(t, 1, len(mutation.GetMutations())) {
+		assert.Equal(t, []byte(part1+part2), mutation.GetMutations()[0].GetSetCell().GetValue())
+	}
+}
+
+// TestReadChangeStream_Retry_ResumeFromContinuationToken tests that client resumes the stream from
+// the last continuation token it received when retrying on transient errors.
+func TestReadChangeStream_Retry_ResumeFromContinuationToken(t *testing.T) {
+	// 0. Common variables
+	const token string = "token-02"
+	partition := dummyPartition("", "")
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *readChangeStreamReqRecord, 3)
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = mockReadChangeStreamFnSimple(recorder,
+		&readChangeStreamAction{dataChange: setCellChange("row-01", "v1", "token-01")},
+		heartbeatAction(partition, token),
+		&readChangeStreamAction{rpcError: codes.Unavailable},
+		&readChangeStreamAction{dataChange: setCellChange("row-02", "v2", "token-03")},
+		&readChangeStreamAction{closeStream: &btpb.ReadChangeStreamResponse_CloseStream{Status: &status.Status{}}},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", partition),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadChangeStreamOp(t, server, &req, nil)
+
+	// 4a. Check that the operation succeeded after the retry
+	checkResultOkStatus(t, res)
+	if !assert.Equal(t, 2, len(recorder)) {
+		return
+	}
+
+	// 4b. Check that the retry resumes from the continuation token of the heartbeat
+	<-recorder
+	retryReq := (<-recorder).req
+	tokens := retryReq.GetContinuationTokens().GetTokens()
+	if assert.Equal(t, 1, len(tokens)) {
+		assert.Equal(t, token, tokens[0].GetToken())
+		if diff := cmp.Diff(partition, tokens[0].GetPartition(), protocmp.Transform()); diff != "" {
+			t.Errorf("diff found (-want +got):\n%s", diff)
+		}
+	}
+	assert.Nil(t, retryReq.GetStartTime())
+}
+
+// TestReadChangeStream_Retry_ExponentialBackoff tests that client will retry using exponential
+// backoff, within the bounds of -backoff_profile.
+func TestReadChangeStream_Retry_ExponentialBackoff(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 4
+	partition := dummyPartition("", "")
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = mockReadChangeStreamFnSimple(nil,
+		&readChangeStreamAction{rpcError: codes.Unavailable},
+		&readChangeStreamAction{rpcError: codes.Unavailable},
+		&readChangeStreamAction{rpcError: codes.Unavailable},
+		&readChangeStreamAction{closeStream: &btpb.ReadChangeStreamResponse_CloseStream{Status: &status.Status{}}},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", partition),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadChangeStreamOp(t, server, &req, nil)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRPCs, len(server.callRecords("ReadChangeStream", "")))
+
+	// 4b. Check the retry delays
+	checkRetryBackoff(t, server, "ReadChangeStream", "")
+}
+
+// TestReadChangeStream_Retry_DeadlineExceeded tests that client sends what is left of the timeout
+// in the grpc-timeout of every attempt, and stops retrying once the timeout is reached.
+func TestReadChangeStream_Retry_DeadlineExceeded(t *testing.T) {
+	// 0. Common variables
+	const maxRPCs int = 10 // More than the attempts that fit in the timeout.
+	const timeout = 2 * time.Second
+	partition := dummyPartition("", "")
+
+	// 1. Instantiate the mock server
+	var actions []*readChangeStreamAction
+	for i := 0; i < maxRPCs; i++ {
+		actions = append(actions, &readChangeStreamAction{rpcError: codes.Unavailable, delayStr: "500ms"})
+	}
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = mockReadChangeStreamFnSimple(nil, actions...)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", partition),
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: durationpb.New(timeout),
+	}
+	res := doReadChangeStreamOp(t, server, &req, &opts)
+
+	// 4a. Check the DeadlineExceeded error
+	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4b. Check the deadlines of the attempts, and that the client did retry
+	assert.Greater(t, checkAttemptDeadlines(t, server, "ReadChangeStream", "", timeout), 1)
+}
+
+// TestReadChangeStream_NoRetry_PartitionSplit tests that client surfaces the new partitions and
+// their continuation tokens when the partition is split, and doesn't retry the stream.
+func TestReadChangeStream_NoRetry_PartitionSplit(t *testing.T) {
+	// 0. Common variables
+	partition := dummyPartition("a", "z")
+	newPartitions := []*btpb.StreamPartition{dummyPartition("a", "m"), dummyPartition("m", "z")}
+	closeStream := &btpb.ReadChangeStreamResponse_CloseStream{
+		Status: &status.Status{Code: int32(codes.OutOfRange), Message: "partition split"},
+		ContinuationTokens: []*btpb.StreamContinuationToken{
+			{Partition: newPartitions[0], Token: "token-a"},
+			{Partition: newPartitions[1], Token: "token-m"},
+		},
+		NewPartitions: newPartitions,
+	}
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *readChangeStreamReqRecord, 2)
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = mockReadChangeStreamFnSimple(recorder,
+		&readChangeStreamAction{dataChange: setCellChange("b", "v1", "token-01")},
+		&readChangeStreamAction{closeStream: closeStream},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", partition),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadChangeStreamOp(t, server, &req, nil)
+
+	// 4. Check that the last record has the new partitions and tokens
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 1, len(recorder))
+	records := res.GetRecords()
+	if !assert.Equal(t, 2, len(records)) {
+		return
+	}
+	if diff := cmp.Diff(closeStream, records[1].GetCloseStream(), protocmp.Transform()); diff != "" {
+		t.Errorf("diff found (-want +got):\n%s", diff)
+	}
+}
+
+// TestReadChangeStream_NoRetry_PartitionMerge tests that client surfaces the merged partition and
+// the continuation tokens to resume it from.
+func TestReadChangeStream_NoRetry_PartitionMerge(t *testing.T) {
+	// 0. Common variables
+	partition := dummyPartition("a", "m")
+	mergedPartition := dummyPartition("a", "z")
+	closeStream := &btpb.ReadChangeStreamResponse_CloseStream{
+		Status: &status.Status{Code: int32(codes.OutOfRange), Message: "partition merge"},
+		ContinuationTokens: []*btpb.StreamContinuationToken{
+			{Partition: partition, Token: "token-a"},
+		},
+		NewPartitions: []*btpb.StreamPartition{mergedPartition},
+	}
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *readChangeStreamReqRecord, 2)
+	server := initMockServer(t)
+	server.ReadChangeStreamFn = mockReadChangeStreamFnSimple(recorder,
+		&readChangeStreamAction{closeStream: closeStream, delayStr: "500ms"},
+	)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadChangeStreamRequest{
+		ClientId: t.Name(),
+		Request:  dummyReadChangeStreamRequest("table", partition),
+	}
+
+	// 3. Perform the operation via test proxy
+	start := time.Now()
+	res := doReadChangeStreamOp(t, server, &req, nil)
+
+	// 4. Check that the only record has the merged partition
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 1, len(recorder))
+	assert.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+	records := res.GetRecords()
+	if !assert.Equal(t, 1, len(records)) {
+		return
+	}
+	if diff := cmp.Diff(closeStream, records[0].GetCloseStream(), protocmp.Transform()); diff != "" {
+		t.Errorf("diff found (-want +got):\n%s", diff)
+	}
+}
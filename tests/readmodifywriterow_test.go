@@ -30,7 +30,6 @@ import (
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -124,13 +123,9 @@ func TestReadModifyWriteRow_Generic_Headers(t *testing.T) {
 	tableName := buildTableName(tableID)
 
 	// 1. Instantiate the mock server
-	// Don't call mockReadRowsFn() as the behavior is to record metadata of the request
-	mdRecords := make(chan metadata.MD, 1)
+	// The server records the metadata of every request, so the mock function only responds
 	server := initMockServer(t)
 	server.ReadModifyWriteRowFn = func(ctx context.Context, req *btpb.ReadModifyWriteRowRequest) (*btpb.ReadModifyWriteRowResponse, error) {
-		md, _ := metadata.FromIncomingContext(ctx)
-		mdRecords <- md
-
 		return &btpb.ReadModifyWriteRowResponse{Row: dummyResultRow(rowKey, increments, appends)}, nil
 	}
 
@@ -147,7 +142,7 @@ func TestReadModifyWriteRow_Generic_Headers(t *testing.T) {
 	doReadModifyWriteRowOp(t, server, &req, &opts)
 
 	// 4. Check the request headers in the metadata
-	md := <-mdRecords
+	md := requireCallRecords(t, server, "ReadModifyWriteRow", "", 1)[0].md
 	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -393,4 +388,37 @@ func TestReadModifyWriteRow_Generic_DeadlineExceeded(t *testing.T) {
 
 	// 4b. Check the DeadlineExceeded error
 	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4c. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "ReadModifyWriteRow", "", 2*time.Second)
+}
+
+// TestReadModifyWriteRow_Generic_SecureConnection tests that client can increment & append values
+// on a TLS-enabled server.
+func TestReadModifyWriteRow_Generic_SecureConnection(t *testing.T) {
+	// 0. Common variables
+	increments := []int64{10}
+	appends := []string{"str1"}
+	rowKey := []byte("row-01")
+	clientReq := dummyReadModifyWriteRowRequest("table", rowKey, increments, appends)
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *readModifyWriteRowReqRecord, 1)
+	action := &readModifyWriteRowAction{row: dummyResultRow(rowKey, increments, appends)}
+	server := initMockServerWithTLS(t, nil)
+	server.ReadModifyWriteRowFn = mockReadModifyWriteRowFnSimple(recorder, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadModifyWriteRowRequest{
+		ClientId: t.Name(),
+		Request:  clientReq,
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadModifyWriteRowOp(t, server, &req, tlsClientOpts(server, ""))
+
+	// 4. Check that the operation succeeded over the secure connection
+	checkResultOkStatus(t, res)
+	assert.Equal(t, rowKey, res.GetRow().GetKey())
+	assert.Equal(t, 1, len(recorder))
 }
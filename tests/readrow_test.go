@@ -19,8 +19,10 @@
 package tests
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -30,7 +32,7 @@ import (
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -43,12 +45,9 @@ func TestReadRow_Generic_Headers(t *testing.T) {
 	tableName := buildTableName("table")
 
 	// 1. Instantiate the mock server
-	// Don't call mockReadRowsFn() as the behavior is to record metadata of the request
-	mdRecords := make(chan metadata.MD, 1)
+	// The server records the metadata of every request, so the mock function only responds
 	server := initMockServer(t)
 	server.ReadRowsFn = func(req *btpb.ReadRowsRequest, srv btpb.Bigtable_ReadRowsServer) error {
-		md, _ := metadata.FromIncomingContext(srv.Context())
-		mdRecords <- md
 		return nil
 	}
 
@@ -66,7 +65,7 @@ func TestReadRow_Generic_Headers(t *testing.T) {
 	doReadRowOp(t, server, &req, &opts)
 
 	// 4. Check the request headers in the metadata
-	md := <-mdRecords
+	md := requireCallRecords(t, server, "ReadRows", "", 1)[0].md
 	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -78,6 +77,44 @@ func TestReadRow_Generic_Headers(t *testing.T) {
 	assert.Contains(t, resource, profileID)
 }
 
+// TestReadRow_Retry_Headers tests that every attempt of ReadRow, including the retries,
+// has the client and resource info, an incrementing attempt counter and a stable operation ID.
+func TestReadRow_Retry_Headers(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	const profileID string = "test_profile"
+	tableName := buildTableName("table")
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, []*readRowsAction{
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowRequest{
+		ClientId:  t.Name(),
+		TableName: tableName,
+		RowKey:    "row-01",
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	res := doReadRowOp(t, server, &req, &opts)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+
+	// 4b. Check the headers of every attempt
+	assert.Equal(t, numRPCs, checkAttemptHeaders(t, server, "ReadRows", "", attemptHeaders{
+		params: map[string]string{"table_name": tableName, "app_profile_id": profileID},
+	}))
+}
+
 // TestReadRow_Generic_DeadlineExceeded tests that client-side timeout is set and respected.
 func TestReadRow_Generic_DeadlineExceeded(t *testing.T) {
 	// 0. Common variables
@@ -119,6 +156,44 @@ func TestReadRow_Generic_DeadlineExceeded(t *testing.T) {
 
 	// 4c. Check the DeadlineExceeded error
 	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4d. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "ReadRows", "", 2*time.Second)
+}
+
+// TestReadRow_Retry_DeadlineExceeded tests that client sends what is left of the timeout
+// in the grpc-timeout of every attempt, and stops retrying once the timeout is reached.
+func TestReadRow_Retry_DeadlineExceeded(t *testing.T) {
+	// 0. Common variables
+	const maxRPCs int = 10 // More than the attempts that fit in the timeout.
+	const timeout = 2 * time.Second
+
+	// 1. Instantiate the mock server
+	var actions []*readRowsAction
+	for i := 0; i < maxRPCs; i++ {
+		actions = append(actions, &readRowsAction{rpcError: codes.Unavailable, delayStr: "500ms"})
+	}
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, actions)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowRequest{
+		ClientId:  t.Name(),
+		TableName: buildTableName("table"),
+		RowKey:    "row-01",
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: durationpb.New(timeout),
+	}
+	res := doReadRowOp(t, server, &req, &opts)
+
+	// 4a. Check the DeadlineExceeded error
+	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4b. Check the deadlines of the attempts, and that the client did retry
+	assert.Greater(t, checkAttemptDeadlines(t, server, "ReadRows", "", timeout), 1)
 }
 
 // TestReadRow_NoRetry_CommitInSeparateChunk tests that client can have one chunk
@@ -316,6 +391,40 @@ func TestReadRow_Generic_CloseClient(t *testing.T) {
 	}
 }
 
+// TestReadRow_Retry_ExponentialBackoff tests that client will retry using exponential backoff,
+// within the bounds of -backoff_profile.
+func TestReadRow_Retry_ExponentialBackoff(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 4
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, []*readRowsAction{
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowRequest{
+		ClientId:  t.Name(),
+		TableName: buildTableName("table"),
+		RowKey:    "row-01",
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowOp(t, server, &req, nil)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+	assert.Equal(t, "row-01", string(res.GetRow().GetKey()))
+	assert.Equal(t, numRPCs, len(server.callRecords("ReadRows", "")))
+
+	// 4b. Check the retry delays
+	checkRetryBackoff(t, server, "ReadRows", "")
+}
+
 // TestReadRow_Retry_WithRoutingCookie tests that routing cookie is handled correctly by the client.
 func TestReadRow_Retry_WithRoutingCookie(t *testing.T) {
 	// 0. Common variable
@@ -329,10 +438,7 @@ func TestReadRow_Retry_WithRoutingCookie(t *testing.T) {
 				dummyChunkData("row-01", "v5", Commit)}},
 	}
 	server := initMockServer(t)
-
-	mdRecords := make(chan metadata.MD, 2)
-	recorder := make(chan *readRowsReqRecord, 2)
-	server.ReadRowsFn = mockReadRowsFnWithMetadata(recorder, mdRecords, sequence)
+	server.ReadRowsFn = mockReadRowsFn(nil, sequence)
 
 	// 2. Build the request to test proxy
 	req := testproxypb.ReadRowRequest{
@@ -349,16 +455,20 @@ func TestReadRow_Retry_WithRoutingCookie(t *testing.T) {
 	assert.Equal(t, "row-01", string(res.Row.Key))
 
 	// 4b. Verify routing cookie is seen
-	// Ignore the first metadata which won't have the routing cookie
-	var _ = <-mdRecords
-	// second metadata which comes from the retry attempt should have a routing cookie field
-	md1 := <-mdRecords
-	val := md1["x-goog-cbt-cookie-test"]
+	// The first attempt won't have the routing cookie, and the retry attempt should have it
+	records := requireCallRecords(t, server, "ReadRows", "", 2)
+	val := records[1].md["x-goog-cbt-cookie-test"]
 	assert.NotEmpty(t, val)
 	if len(val) == 0 {
 		return
 	}
 	assert.Equal(t, cookie, val[0])
+
+	// 4c. Check that the routing cookie is only sent on the retries, with the other headers
+	checkAttemptHeaders(t, server, "ReadRows", "", attemptHeaders{
+		params:  map[string]string{"table_name": buildTableName("table")},
+		cookies: []string{"", cookie},
+	})
 }
 
 // TestReadRow_Retry_WithRetryInfo tests that RetryInfo is handled correctly by the client.
@@ -401,3 +511,128 @@ func TestReadRow_Retry_WithRetryInfo(t *testing.T) {
 
 	assert.True(t, delta >= 2, fmt.Sprintf("Expected retry to happen after 2ms, got %dms", delta))
 }
+
+// TestReadRow_Retry_FaultRuleByRequest tests that client only retries the request that failed,
+// when a fault rule of the server targets one of several concurrent requests.
+func TestReadRow_Retry_FaultRuleByRequest(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	const numRows int = 2
+	const faultyRowKey string = "row-0"
+
+	// 1. Instantiate the mock server, whose table has two rows. The first request for
+	// `faultyRowKey` will fail with a transient error.
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+	if _, err := server.store.mutateRows(context.Background(), dummyMutateRowsRequest(tableID, numRows)); err != nil {
+		t.Fatalf("Failed to write the rows to the store: %v", err)
+	}
+	recorder := make(chan *readRowsReqRecord, numRows)
+	server.ReadRowsFn = mockReadRowsFnWithStore(server.store, recorder)
+	rule := &faultRule{
+		method:  "ReadRows",
+		attempt: 1,
+		match: func(req proto.Message) bool {
+			rowKeys := req.(*btpb.ReadRowsRequest).GetRows().GetRowKeys()
+			return len(rowKeys) > 0 && string(rowKeys[0]) == faultyRowKey
+		},
+		rpcError: codes.Unavailable,
+	}
+	server.injectFaults(rule)
+
+	// 2. Build the requests to test proxy
+	reqs := make([]*testproxypb.ReadRowRequest, numRows)
+	for i := 0; i < numRows; i++ {
+		reqs[i] = &testproxypb.ReadRowRequest{
+			ClientId:  t.Name(),
+			TableName: buildTableName(tableID),
+			RowKey:    "row-" + strconv.Itoa(i),
+		}
+	}
+
+	// 3. Perform the operations via test proxy
+	results := doReadRowOps(t, server, reqs, nil)
+
+	// 4a. Check that all the requests succeeded
+	assert.Equal(t, numRows, len(results))
+	checkResultOkStatus(t, results...)
+	for i := 0; i < numRows; i++ {
+		assert.Equal(t, reqs[i].GetRowKey(), string(results[i].GetRow().GetKey()))
+	}
+
+	// 4b. Check that only the faulty request was retried. The failed attempt doesn't reach the
+	// mock function, so it's counted by the fault rule only.
+	assert.Equal(t, 2, server.faultRuleSeen(rule))
+	assert.Equal(t, numRows, len(recorder))
+	for i := 0; i < numRows; i++ {
+		loggedReq := <-recorder
+		assert.Equal(t, 1, len(loggedReq.req.GetRows().GetRowKeys()))
+	}
+}
+
+// TestReadRow_Generic_ConcurrentStreamsLimit tests that client respects the limit of concurrent
+// streams advertised by the server, so that no request fails when the limit is saturated.
+func TestReadRow_Generic_ConcurrentStreamsLimit(t *testing.T) {
+	// 0. Common variables
+	rowKeys := []string{"op0-row", "op1-row", "op2-row"}
+	concurrency := len(rowKeys)
+
+	// 1. Instantiate the mock server, which allows one stream per connection
+	recorder := make(chan *readRowsReqRecord, concurrency)
+	actions := make([]*readRowsAction, concurrency)
+	for i := 0; i < concurrency; i++ {
+		actions[i] = &readRowsAction{
+			chunks:   []chunkData{dummyChunkData(rowKeys[i], fmt.Sprintf("value%d", i), Commit)},
+			delayStr: "500ms",
+		}
+	}
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFnSimple(recorder, actions...)
+	server.limitConcurrentStreams(t, 1)
+
+	// 2. Build the requests to test proxy
+	reqs := make([]*testproxypb.ReadRowRequest, concurrency)
+	for i := 0; i < concurrency; i++ {
+		reqs[i] = &testproxypb.ReadRowRequest{
+			ClientId:  t.Name(),
+			TableName: buildTableName("table"),
+			RowKey:    rowKeys[i],
+		}
+	}
+
+	// 3. Perform the operations via test proxy
+	results := doReadRowOps(t, server, reqs, nil)
+
+	// 4. Check that all the requests succeeded
+	assert.Equal(t, concurrency, len(results))
+	checkResultOkStatus(t, results...)
+	for i := 0; i < concurrency; i++ {
+		assert.Equal(t, rowKeys[i], string(results[i].GetRow().GetKey()))
+	}
+}
+
+// TestReadRow_Generic_SecureConnection tests that client can read a row from a TLS-enabled server.
+func TestReadRow_Generic_SecureConnection(t *testing.T) {
+	// 0. Common variables
+	const rowKey string = "row-01"
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *readRowsReqRecord, 1)
+	action := &readRowsAction{chunks: []chunkData{dummyChunkData(rowKey, "v1", Commit)}}
+	server := initMockServerWithTLS(t, nil)
+	server.ReadRowsFn = mockReadRowsFnSimple(recorder, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowRequest{
+		ClientId:  t.Name(),
+		TableName: buildTableName("table"),
+		RowKey:    rowKey,
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowOp(t, server, &req, tlsClientOpts(server, ""))
+
+	// 4. Check that the operation succeeded over the secure connection
+	checkResultOkStatus(t, res)
+	assert.Equal(t, rowKey, string(res.GetRow().GetKey()))
+	assert.Equal(t, 1, len(recorder))
+}
@@ -0,0 +1,119 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file loads the ReadRows acceptance tests published by googleapis at
+// https://github.com/googleapis/conformance-tests/tree/main/bigtable/v2, which
+// hold the canonical semantics of merging the cell chunks into rows. The copy
+// in testdata/readrows_acceptance.json should be refreshed from there.
+package tests
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// readRowsAcceptanceFile is the path of the ReadRows acceptance tests.
+const readRowsAcceptanceFile = "testdata/readrows_acceptance.json"
+
+// readRowsAcceptanceTest is a case in the ReadRows acceptance tests: the chunks sent by the server,
+// and the cells the client is expected to return in order.
+type readRowsAcceptanceTest struct {
+	Description string                    `json:"description"`
+	Chunks      []json.RawMessage         `json:"chunks"` // ReadRowsResponse.CellChunk in the JSON format of protos.
+	Results     []*readRowsAcceptanceCell `json:"results"`
+}
+
+// readRowsAcceptanceCell is an expected cell of a readRowsAcceptanceTest. A cell with `Error` set
+// means that the client is expected to fail.
+type readRowsAcceptanceCell struct {
+	RowKey          string `json:"rowKey"`
+	FamilyName      string `json:"familyName"`
+	Qualifier       string `json:"qualifier"`
+	TimestampMicros int64  `json:"timestampMicros,string"`
+	Value           string `json:"value"`
+	Label           string `json:"label"`
+	Error           bool   `json:"error"`
+}
+
+// loadReadRowsAcceptanceTests reads the ReadRows acceptance tests from `file`.
+func loadReadRowsAcceptanceTests(file string) ([]*readRowsAcceptanceTest, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var tests struct {
+		ReadRowsTests []*readRowsAcceptanceTest `json:"readRowsTests"`
+	}
+	if err := json.Unmarshal(content, &tests); err != nil {
+		return nil, fmt.Errorf("invalid acceptance tests %s: %v", file, err)
+	}
+	return tests.ReadRowsTests, nil
+}
+
+// action returns the readRowsAction that sends the chunks of the test as they are.
+func (rt *readRowsAcceptanceTest) action() (*readRowsAction, error) {
+	action := &readRowsAction{}
+	for i, raw := range rt.Chunks {
+		chunk := &btpb.ReadRowsResponse_CellChunk{}
+		if err := protojson.Unmarshal(raw, chunk); err != nil {
+			return nil, fmt.Errorf("invalid chunk %d of %q: %v", i, rt.Description, err)
+		}
+		action.rawChunks = append(action.rawChunks, chunk)
+	}
+	return action, nil
+}
+
+// wantError returns whether the client is expected to fail the test.
+func (rt *readRowsAcceptanceTest) wantError() bool {
+	for _, cell := range rt.Results {
+		if cell.Error {
+			return true
+		}
+	}
+	return false
+}
+
+// wantRows returns the rows the client is expected to return, which are built by grouping the
+// consecutive cells of the same row, family and qualifier.
+func (rt *readRowsAcceptanceTest) wantRows() []*btpb.Row {
+	var rows []*btpb.Row
+	var family *btpb.Family
+	var column *btpb.Column
+	for _, cell := range rt.Results {
+		if len(rows) == 0 || string(rows[len(rows)-1].Key) != cell.RowKey {
+			rows = append(rows, &btpb.Row{Key: []byte(cell.RowKey)})
+			family, column = nil, nil
+		}
+		row := rows[len(rows)-1]
+		if family == nil || family.Name != cell.FamilyName {
+			family = &btpb.Family{Name: cell.FamilyName}
+			row.Families = append(row.Families, family)
+			column = nil
+		}
+		if column == nil || string(column.Qualifier) != cell.Qualifier {
+			column = &btpb.Column{Qualifier: []byte(cell.Qualifier)}
+			family.Columns = append(family.Columns, column)
+		}
+		c := &btpb.Cell{TimestampMicros: cell.TimestampMicros, Value: []byte(cell.Value)}
+		if cell.Label != "" {
+			c.Labels = []string{cell.Label}
+		}
+		column.Cells = append(column.Cells, c)
+	}
+	return rows
+}
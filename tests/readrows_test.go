@@ -18,9 +18,12 @@
 package tests
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"path/filepath"
 	"reflect"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -29,6 +32,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/http2"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/keepalive"
@@ -51,12 +55,9 @@ func TestReadRows_Generic_Headers(t *testing.T) {
 	tableName := buildTableName("table")
 
 	// 1. Instantiate the mock server
-	// Don't call mockReadRowsFn() as the behavior is to record metadata of the request
-	mdRecords := make(chan metadata.MD, 1)
+	// The server records the metadata of every request, so the mock function only responds
 	server := initMockServer(t)
 	server.ReadRowsFn = func(req *btpb.ReadRowsRequest, srv btpb.Bigtable_ReadRowsServer) error {
-		md, _ := metadata.FromIncomingContext(srv.Context())
-		mdRecords <- md
 		return nil
 	}
 
@@ -73,7 +74,7 @@ func TestReadRows_Generic_Headers(t *testing.T) {
 	doReadRowsOp(t, server, &req, &opts)
 
 	// 4. Check the request headers in the metadata
-	md := <-mdRecords
+	md := requireCallRecords(t, server, "ReadRows", "", 1)[0].md
 	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -85,6 +86,241 @@ func TestReadRows_Generic_Headers(t *testing.T) {
 	assert.Contains(t, resource, profileID)
 }
 
+// TestReadRows_Retry_Headers tests that every attempt of ReadRows, including the retries,
+// has the client and resource info, an incrementing attempt counter and a stable operation ID.
+func TestReadRows_Retry_Headers(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	const profileID string = "test_profile"
+	tableName := buildTableName("table")
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, []*readRowsAction{
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: tableName},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	res := doReadRowsOp(t, server, &req, &opts)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+
+	// 4b. Check the headers of every attempt
+	assert.Equal(t, numRPCs, checkAttemptHeaders(t, server, "ReadRows", "", attemptHeaders{
+		params: map[string]string{"table_name": tableName, "app_profile_id": profileID},
+	}))
+}
+
+// TestReadRows_Retry_AuthorizedViewHeaders tests that every attempt of ReadRows, including the retries,
+// has the client and resource info of an authorized view, an incrementing attempt counter and a stable operation ID.
+func TestReadRows_Retry_AuthorizedViewHeaders(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	const profileID string = "test_profile"
+	viewName := buildTableName("table") + "/authorizedViews/view"
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, []*readRowsAction{
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{AuthorizedViewName: viewName},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	res := doReadRowsOp(t, server, &req, &opts)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+
+	// 4b. Check the headers of every attempt
+	assert.Equal(t, numRPCs, checkAttemptHeaders(t, server, "ReadRows", "", attemptHeaders{
+		params: map[string]string{"authorized_view_name": viewName, "app_profile_id": profileID},
+	}))
+}
+
+// TestReadRows_Retry_ClientMetrics tests that the client exports the built-in metrics of ReadRows,
+// which count the operation and its attempts, with the location reported by the server.
+func TestReadRows_Retry_ClientMetrics(t *testing.T) {
+	// 0. Common variables
+	const profileID string = "test_profile"
+	const clusterID string = "test-cluster"
+	const zoneID string = "us-central1-b"
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.setLocation(clusterID, zoneID)
+	server.ReadRowsFn = mockReadRowsFn(nil, []*readRowsAction{
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy, where the metrics are exported when the client is closed
+	opts := clientOpts{
+		profile: profileID,
+		metrics: true,
+	}
+	res := doReadRowsOp(t, server, &req, &opts)
+
+	// 4. Check that the operation succeeded, and the metrics count the retries
+	checkResultOkStatus(t, res)
+	checkClientMetrics(t, server, clientMetrics{
+		method:  "Bigtable.ReadRows",
+		profile: profileID,
+		resource: map[string]string{
+			"project_id": projectID, "instance": instanceID, "table": "table", "cluster": clusterID, "zone": zoneID,
+		},
+		points: []metricPoint{
+			{name: "operation_latencies", status: codes.OK, value: 1},
+			{name: "attempt_latencies", status: codes.Unavailable, value: 2},
+			{name: "attempt_latencies", status: codes.OK, value: 1},
+			{name: "retry_count", status: codes.OK, value: 2},
+		},
+	})
+}
+
+// TestReadRows_Retry_ServerTimingAndLocation tests that the client attributes the latency of each
+// ReadRows attempt to the server and to the location in its response headers or trailers.
+func TestReadRows_Retry_ServerTimingAndLocation(t *testing.T) {
+	// 1. Instantiate the mock server, where the failed attempt reports its location in the
+	// headers, and the successful one in the trailers
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, []*readRowsAction{
+		&readRowsAction{
+			rpcError: codes.Unavailable,
+			header:   metadata.Join(serverTimingMD(20*time.Millisecond), locationMD("cluster-a", "zone-a")),
+		},
+		&readRowsAction{
+			chunks:  []chunkData{dummyChunkData("row-01", "v1", Commit)},
+			trailer: metadata.Join(serverTimingMD(40*time.Millisecond), locationMD("cluster-b", "zone-b")),
+		},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy, where the metrics are exported when the client is closed
+	res := doReadRowsOp(t, server, &req, &clientOpts{metrics: true})
+
+	// 4. Check that the operation succeeded, and each attempt is attributed to its location
+	checkResultOkStatus(t, res)
+	checkClientMetrics(t, server, clientMetrics{
+		method:   "Bigtable.ReadRows",
+		resource: map[string]string{"cluster": "cluster-a", "zone": "zone-a"},
+		points: []metricPoint{
+			{name: "attempt_latencies", status: codes.Unavailable, value: 1},
+			{name: "server_latencies", status: codes.Unavailable, value: 1, mean: 20},
+		},
+	})
+	checkClientMetrics(t, server, clientMetrics{
+		method:   "Bigtable.ReadRows",
+		resource: map[string]string{"cluster": "cluster-b", "zone": "zone-b"},
+		points: []metricPoint{
+			{name: "operation_latencies", status: codes.OK, value: 1},
+			{name: "attempt_latencies", status: codes.OK, value: 1},
+			{name: "server_latencies", status: codes.OK, value: 1, mean: 40},
+		},
+	})
+}
+
+// TestReadRows_Retry_TraceContext tests that every attempt of ReadRows, including the retries,
+// propagates the trace of the operation in a span of its own.
+func TestReadRows_Retry_TraceContext(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	parent := newTraceContext()
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, []*readRowsAction{
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId:    t.Name(),
+		Request:     &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+		Traceparent: parent.traceparent(),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowsOp(t, server, &req, nil)
+
+	// 4. Check that the operation succeeded, and every attempt is a span in the trace
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRPCs, len(checkAttemptTraces(t, server, "ReadRows", "", parent)))
+}
+
+// TestReadRows_Generic_TraceContextPerOperation tests that concurrent ReadRows operations propagate
+// their own traces.
+func TestReadRows_Generic_TraceContextPerOperation(t *testing.T) {
+	// 0. Common variables
+	const concurrency = 2
+	parents := []traceContext{newTraceContext(), newTraceContext()}
+
+	// 1. Instantiate the mock server, which serves each operation by the "opX-" prefix of its row key
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFnSimple(nil,
+		&readRowsAction{chunks: []chunkData{dummyChunkData("op0-row", "v0", Commit)}},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("op1-row", "v1", Commit)}},
+	)
+
+	// 2. Build the requests to test proxy
+	reqs := make([]*testproxypb.ReadRowsRequest, concurrency)
+	for i := range reqs {
+		reqs[i] = &testproxypb.ReadRowsRequest{
+			ClientId: t.Name(),
+			Request: &btpb.ReadRowsRequest{
+				TableName: buildTableName("table"),
+				Rows:      &btpb.RowSet{RowKeys: [][]byte{[]byte(fmt.Sprintf("op%d-row", i))}},
+			},
+			Traceparent: parents[i].traceparent(),
+		}
+	}
+
+	// 3. Perform the operations via test proxy
+	results := doReadRowsOps(t, server, reqs, nil)
+
+	// 4. Check that the operations succeeded, and each one is in its own trace
+	for i, res := range results {
+		checkResultOkStatus(t, res)
+		checkAttemptTraces(t, server, "ReadRows", fmt.Sprintf("op%d-", i), parents[i])
+	}
+}
+
 // TestReadRows_NoRetry_OutOfOrderError tests that client will fail on receiving out of order row keys.
 func TestReadRows_NoRetry_OutOfOrderError(t *testing.T) {
 	// 1. Instantiate the mock server
@@ -115,12 +351,9 @@ func TestReadRows_NoRetry_OutOfOrderError(t *testing.T) {
 
 func TestReadRows_ReverseScans_FeatureFlag_Enabled(t *testing.T) {
 	// 1. Instantiate the mock server
-	// Don't call mockReadRowsFn() as the behavior is to record metadata of the request
-	mdRecords := make(chan metadata.MD, 1)
+	// The server records the metadata of every request, so the mock function only responds
 	server := initMockServer(t)
 	server.ReadRowsFn = func(req *btpb.ReadRowsRequest, srv btpb.Bigtable_ReadRowsServer) error {
-		md, _ := metadata.FromIncomingContext(srv.Context())
-		mdRecords <- md
 		return nil
 	}
 
@@ -134,7 +367,7 @@ func TestReadRows_ReverseScans_FeatureFlag_Enabled(t *testing.T) {
 	doReadRowsOp(t, server, &req, nil)
 
 	// 4. Check the request headers in the metadata
-	md := <-mdRecords
+	md := requireCallRecords(t, server, "ReadRows", "", 1)[0].md
 
 	ff, err := getClientFeatureFlags(md)
 	assert.Nil(t, err, "failed to decode client feature flags")
@@ -169,6 +402,46 @@ func TestReadRows_NoRetry_OutOfOrderError_Reverse(t *testing.T) {
 	t.Logf("The full error message is: %s", res.GetStatus().GetMessage())
 }
 
+// TestReadRows_NoRetry_Acceptance tests that client merges the cell chunks into rows following the
+// ReadRows acceptance tests published by googleapis, where each case runs as a subtest.
+func TestReadRows_NoRetry_Acceptance(t *testing.T) {
+	acceptanceTests, err := loadReadRowsAcceptanceTests(readRowsAcceptanceFile)
+	if err != nil {
+		t.Fatalf("Failed to load the acceptance tests: %v", err)
+	}
+
+	for _, at := range acceptanceTests {
+		t.Run(at.Description, func(t *testing.T) {
+			// 1. Instantiate the mock server
+			action, err := at.action()
+			if err != nil {
+				t.Fatal(err)
+			}
+			server := initMockServer(t)
+			server.ReadRowsFn = mockReadRowsFnSimple(nil, action)
+
+			// 2. Build the request to test proxy
+			req := testproxypb.ReadRowsRequest{
+				ClientId: t.Name(),
+				Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+			}
+
+			// 3. Perform the operation via test proxy
+			res := doReadRowsOp(t, server, &req, nil)
+
+			// 4. Check the result against the expected error or rows
+			if at.wantError() {
+				assert.NotEqual(t, int32(codes.OK), res.GetStatus().GetCode(), "client should fail the invalid chunks")
+				return
+			}
+			checkResultOkStatus(t, res)
+			if diff := cmp.Diff(at.wantRows(), res.GetRows(), protocmp.Transform()); diff != "" {
+				t.Errorf("Rows mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 // TestReadRows_NoRetry_ErrorAfterLastRow tests that when receiving a transient error after receiving
 // the last row, the read will still finish successfully.
 func TestReadRows_NoRetry_ErrorAfterLastRow(t *testing.T) {
@@ -410,6 +683,86 @@ func TestReadRows_Generic_MultiStreams(t *testing.T) {
 	}
 }
 
+// TestReadRows_Generic_MultiStreams_RowRanges tests that client can have multiple concurrent range
+// scans on different tables, and each scan gets its own responses and retries.
+func TestReadRows_Generic_MultiStreams_RowRanges(t *testing.T) {
+	// 0. Common variable
+	const concurrency = 3
+	const retriedOp = 1
+
+	// 1. Instantiate the mock server
+	// The requests are routed by table name, as range scans don't have the row key prefix "opX-".
+	fns := make(map[string]func(*btpb.ReadRowsRequest, btpb.Bigtable_ReadRowsServer) error)
+	for i := 0; i < concurrency; i++ {
+		sequence := []*readRowsAction{
+			&readRowsAction{
+				chunks:   []chunkData{dummyChunkData(fmt.Sprintf("row-%d-a", i), "v1", Commit)},
+				delayStr: "2s",
+			},
+		}
+		if i == retriedOp {
+			sequence = append(sequence,
+				&readRowsAction{rpcError: codes.Unavailable},
+				&readRowsAction{chunks: []chunkData{dummyChunkData(fmt.Sprintf("row-%d-b", i), "v2", Commit)}})
+		}
+		fns[buildTableName(fmt.Sprintf("table%d", i))] = mockReadRowsFn(nil, sequence)
+	}
+	server := initMockServer(t)
+	server.ReadRowsFn = routeStreamFn("ReadRows", routeByTableName, fns)
+
+	// 2. Build the requests to test proxy
+	reqs := make([]*testproxypb.ReadRowsRequest, concurrency)
+	for i := 0; i < concurrency; i++ {
+		reqs[i] = &testproxypb.ReadRowsRequest{
+			ClientId: t.Name(),
+			Request: &btpb.ReadRowsRequest{
+				TableName: buildTableName(fmt.Sprintf("table%d", i)),
+				Rows: &btpb.RowSet{
+					RowRanges: []*btpb.RowRange{
+						{
+							StartKey: &btpb.RowRange_StartKeyClosed{StartKeyClosed: []byte(fmt.Sprintf("row-%d", i))},
+							EndKey:   &btpb.RowRange_EndKeyOpen{EndKeyOpen: []byte(fmt.Sprintf("row-%d-z", i))},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	// 3. Perform the operations via test proxy
+	results := doReadRowsOps(t, server, reqs, nil)
+
+	// 4a. Check that all the requests succeeded with their own rows
+	assert.Equal(t, concurrency, len(results))
+	checkResultOkStatus(t, results...)
+	for i, res := range results {
+		wantRows := 1
+		if i == retriedOp {
+			wantRows = 2
+		}
+		if assert.Equal(t, wantRows, len(res.GetRows())) {
+			assert.Equal(t, fmt.Sprintf("row-%d-a", i), string(res.GetRows()[0].GetKey()))
+		}
+	}
+
+	// 4b. Check that only the scan with error is retried, from where it was interrupted
+	records := server.callRecords("ReadRows", "")
+	assert.Equal(t, concurrency+1, len(records))
+	retried := 0
+	for _, record := range records {
+		req := record.req.(*btpb.ReadRowsRequest)
+		if req.GetTableName() != buildTableName(fmt.Sprintf("table%d", retriedOp)) {
+			continue
+		}
+		if retried++; retried == 2 {
+			if ranges := req.GetRows().GetRowRanges(); assert.NotEmpty(t, ranges) {
+				assert.Equal(t, fmt.Sprintf("row-%d-a", retriedOp), string(ranges[0].GetStartKeyOpen()))
+			}
+		}
+	}
+	assert.Equal(t, 2, retried)
+}
+
 // TestReadRows_Retry_StreamReset tests that client will retry on stream reset.
 func TestReadRows_Retry_StreamReset(t *testing.T) {
 	// 0. Common variable
@@ -810,6 +1163,80 @@ func TestReadRows_Generic_DeadlineExceeded(t *testing.T) {
 		msg := res.GetStatus().GetMessage()
 		assert.Contains(t, strings.ToLower(strings.ReplaceAll(msg, " ", "")), "deadlineexceeded")
 	}
+
+	// 4c. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "ReadRows", "", 2*time.Second)
+}
+
+// TestReadRows_Retry_DeadlineExceeded tests that client sends what is left of the timeout
+// in the grpc-timeout of every attempt, and stops retrying once the timeout is reached.
+func TestReadRows_Retry_DeadlineExceeded(t *testing.T) {
+	// 0. Common variables
+	const maxRPCs int = 10 // More than the attempts that fit in the timeout.
+	const timeout = 2 * time.Second
+
+	// 1. Instantiate the mock server
+	var actions []*readRowsAction
+	for i := 0; i < maxRPCs; i++ {
+		actions = append(actions, &readRowsAction{rpcError: codes.Unavailable, delayStr: "500ms"})
+	}
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, actions)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: durationpb.New(timeout),
+	}
+	res := doReadRowsOp(t, server, &req, &opts)
+
+	// 4a. Check the DeadlineExceeded error
+	// Some clients wrap the error code in the message, so check the message if error code is not right.
+	if res.GetStatus().GetCode() != int32(codes.DeadlineExceeded) {
+		msg := res.GetStatus().GetMessage()
+		assert.Contains(t, strings.ToLower(strings.ReplaceAll(msg, " ", "")), "deadlineexceeded")
+	}
+
+	// 4b. Check the deadlines of the attempts, and that the client did retry
+	assert.Greater(t, checkAttemptDeadlines(t, server, "ReadRows", "", timeout), 1)
+}
+
+// TestReadRows_Retry_ExponentialBackoff tests that client will retry using exponential backoff,
+// within the bounds of -backoff_profile.
+func TestReadRows_Retry_ExponentialBackoff(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 4
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(nil, []*readRowsAction{
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowsOp(t, server, &req, nil)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 1, len(res.GetRows()))
+	assert.Equal(t, numRPCs, len(server.callRecords("ReadRows", "")))
+
+	// 4b. Check the retry delays
+	checkRetryBackoff(t, server, "ReadRows", "")
 }
 
 // TestReadRows_Retry_WithRoutingCookie tests that routing cookie is handled correctly by the client.
@@ -828,10 +1255,7 @@ func TestReadRows_Retry_WithRoutingCookie(t *testing.T) {
 				dummyChunkData("row-05", "v5", Commit)}},
 	}
 	server := initMockServer(t)
-
-	mdRecords := make(chan metadata.MD, 2)
-	recorder := make(chan *readRowsReqRecord, 2)
-	server.ReadRowsFn = mockReadRowsFnWithMetadata(recorder, mdRecords, sequence)
+	server.ReadRowsFn = mockReadRowsFn(nil, sequence)
 
 	// 2. Build the request to test proxy
 	req := testproxypb.ReadRowsRequest{
@@ -851,11 +1275,9 @@ func TestReadRows_Retry_WithRoutingCookie(t *testing.T) {
 	assert.Equal(t, "row-05", string(res.Rows[1].Key))
 
 	// 4b. Verify routing cookie is seen
-	// Ignore the first metadata which won't have the routing cookie
-	var _ = <-mdRecords
-	// second metadata which comes from the retry attempt should have a routing cookie field
-	md1 := <-mdRecords
-	val := md1["x-goog-cbt-cookie-test"]
+	// The first attempt won't have the routing cookie, and the retry attempt should have it
+	records := requireCallRecords(t, server, "ReadRows", "", 2)
+	val := records[1].md["x-goog-cbt-cookie-test"]
 	assert.NotEmpty(t, val)
 	if len(val) == 0 {
 		return
@@ -863,9 +1285,14 @@ func TestReadRows_Retry_WithRoutingCookie(t *testing.T) {
 	assert.Equal(t, cookie, val[0])
 
 	// 4c. Verify retry request is correct
-	var _ = <-recorder
-	retryReq := <-recorder
-	assert.True(t, cmp.Equal(retryReq.req.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-01")}))
+	retryReq := records[1].req.(*btpb.ReadRowsRequest)
+	assert.True(t, cmp.Equal(retryReq.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-01")}))
+
+	// 4d. Check that the routing cookie is only sent on the retries, with the other headers
+	checkAttemptHeaders(t, server, "ReadRows", "", attemptHeaders{
+		params:  map[string]string{"table_name": buildTableName("table")},
+		cookies: []string{"", cookie},
+	})
 }
 
 // TestReadRows_Retry_WithRoutingCookie_MultipleErrorResponses tests handling of routing cookie
@@ -890,10 +1317,7 @@ func TestReadRows_Retry_WithRoutingCookie_MultipleErrorResponses(t *testing.T) {
 				dummyChunkData("row-05", "v5", Commit)}},
 	}
 	server := initMockServer(t)
-
-	mdRecords := make(chan metadata.MD, 4)
-	recorder := make(chan *readRowsReqRecord, 4)
-	server.ReadRowsFn = mockReadRowsFnWithMetadata(recorder, mdRecords, sequence)
+	server.ReadRowsFn = mockReadRowsFn(nil, sequence)
 
 	// 2. Build the request to test proxy
 	req := testproxypb.ReadRowsRequest{
@@ -918,33 +1342,32 @@ func TestReadRows_Retry_WithRoutingCookie_MultipleErrorResponses(t *testing.T) {
 	}
 
 	// 4b. Verify routing cookie is seen
-	// Ignore the first metadata which won't have the routing cookie
-	var _ = <-mdRecords
-	// second metadata which comes from the retry attempt should have a routing cookie field
-	md1 := <-mdRecords
-	val1 := md1["x-goog-cbt-cookie-test"]
+	// The first attempt won't have the routing cookie, and the first retry attempt should have it
+	records := requireCallRecords(t, server, "ReadRows", "", 4)
+	val1 := records[1].md["x-goog-cbt-cookie-test"]
 	assert.NotEmpty(t, val1)
 	if len(val1) == 0 {
 		return
 	}
 	assert.Equal(t, cookie, val1[0])
-	// third metadata which comes from the 2nd retry attempt should use the same routing cookie
-	md2 := <-mdRecords
-	val2 := md2["x-goog-cbt-cookie-test"]
-	assert.Equal(t, cookie, val2[0])
-	// 4th metadata which comes from the 3rd retry attempt should have the new routing cookie
-	md3 := <-mdRecords
-	val3 := md3["x-goog-cbt-cookie-test"]
-	assert.Equal(t, newCookie, val3[0])
+	// The 2nd retry attempt should use the same routing cookie
+	val2 := records[2].md["x-goog-cbt-cookie-test"]
+	assert.Equal(t, []string{cookie}, val2)
+	// The 3rd retry attempt should have the new routing cookie
+	val3 := records[3].md["x-goog-cbt-cookie-test"]
+	assert.Equal(t, []string{newCookie}, val3)
+
+	// 4c. Verify retry requests are correct
+	for _, record := range records[1:] {
+		retryReq := record.req.(*btpb.ReadRowsRequest)
+		assert.True(t, cmp.Equal(retryReq.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-01")}))
+	}
 
-	// 4c. Verify retry request is correct
-	var _ = <-recorder
-	retryReq1 := <-recorder
-	assert.True(t, cmp.Equal(retryReq1.req.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-01")}))
-	retryReq2 := <-recorder
-	assert.True(t, cmp.Equal(retryReq2.req.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-01")}))
-	retryReq3 := <-recorder
-	assert.True(t, cmp.Equal(retryReq3.req.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-01")}))
+	// 4d. Check that the routing cookie is only sent on the retries, with the other headers
+	checkAttemptHeaders(t, server, "ReadRows", "", attemptHeaders{
+		params:  map[string]string{"table_name": buildTableName("table")},
+		cookies: []string{"", cookie, cookie, newCookie},
+	})
 }
 
 // TestReadRows_Retry_WithRetryInfo tests that RetryInfo is handled correctly by the client.
@@ -1086,3 +1509,367 @@ func TestReadRows_Retry_WithRetryInfo_OverallDedaline(t *testing.T) {
 	runTimeSecs := int(curTs.Unix() - loggedReq.ts.Unix())
 	assert.Less(t, runTimeSecs, 4) // 4s is much smaller than combined retry delay indicates timeout takes effect.
 }
+
+// TestReadRows_Retry_StoredRowsResumption tests that client will resume the scan after the rows
+// that have been served from the stored data, and return all the stored rows.
+func TestReadRows_Retry_StoredRowsResumption(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	const numRows int = 3
+
+	// 1. Instantiate the mock server, whose table has three rows
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+	if _, err := server.store.mutateRows(context.Background(), dummyMutateRowsRequest(tableID, numRows)); err != nil {
+		t.Fatalf("Failed to write the rows to the store: %v", err)
+	}
+	recorder := make(chan *readRowsReqRecord, 2)
+	sequence := []*readRowsAction{
+		&readRowsAction{numRows: 1},
+		&readRowsAction{rpcError: codes.Unavailable}, // The remaining rows are served in the retry
+	}
+	server.ReadRowsFn = mockReadRowsFnWithStore(server.store, recorder, sequence)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName(tableID)},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowsOp(t, server, &req, nil)
+
+	// 4a. Verify that all the stored rows were read successfully
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRows, len(res.GetRows()))
+	for i, row := range res.GetRows() {
+		assert.Equal(t, "row-"+strconv.Itoa(i), string(row.Key))
+		assert.Equal(t, "value", string(row.GetFamilies()[0].GetColumns()[0].GetCells()[0].GetValue()))
+	}
+
+	// 4b. Verify that the retry request excluded the row that had been served
+	assert.Equal(t, 2, len(recorder))
+	<-recorder
+	retryReq := <-recorder
+	assert.True(t, cmp.Equal(retryReq.req.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-0")}))
+}
+
+// TestReadRows_Retry_FaultAfterMessages tests that client will resume the scan after the rows
+// received before the stream is broken by a fault rule of the server.
+func TestReadRows_Retry_FaultAfterMessages(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	const numRows int = 3
+
+	// 1. Instantiate the mock server, whose table has three rows. The first attempt will be
+	// broken after one row is sent.
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+	if _, err := server.store.mutateRows(context.Background(), dummyMutateRowsRequest(tableID, numRows)); err != nil {
+		t.Fatalf("Failed to write the rows to the store: %v", err)
+	}
+	recorder := make(chan *readRowsReqRecord, 2)
+	server.ReadRowsFn = mockReadRowsFnWithStore(server.store, recorder)
+	server.injectFaults(&faultRule{
+		method:        "ReadRows",
+		attempt:       1,
+		afterMessages: 1,
+		rpcError:      codes.Unavailable,
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName(tableID)},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowsOp(t, server, &req, nil)
+
+	// 4a. Verify that all the stored rows were read successfully
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRows, len(res.GetRows()))
+	for i, row := range res.GetRows() {
+		assert.Equal(t, "row-"+strconv.Itoa(i), string(row.Key))
+	}
+
+	// 4b. Verify that the retry request excluded the row that had been received
+	assert.Equal(t, 2, len(recorder))
+	<-recorder
+	retryReq := <-recorder
+	assert.True(t, cmp.Equal(retryReq.req.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-0")}))
+}
+
+// TestReadRows_Retry_RstStreamMidStream tests that client will resume the scan after the rows
+// received before the stream is reset by RST_STREAM.
+func TestReadRows_Retry_RstStreamMidStream(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	const numRows int = 3
+
+	// 1. Instantiate the mock server, whose table has three rows. The first attempt will be reset
+	// after one row is sent.
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+	if _, err := server.store.mutateRows(context.Background(), dummyMutateRowsRequest(tableID, numRows)); err != nil {
+		t.Fatalf("Failed to write the rows to the store: %v", err)
+	}
+	recorder := make(chan *readRowsReqRecord, 2)
+	server.ReadRowsFn = mockReadRowsFnWithStore(server.store, recorder)
+	server.injectTransportFaults(t, &transportFault{
+		kind:          rstStream,
+		method:        "ReadRows",
+		attempt:       1,
+		afterMessages: 1,
+		errCode:       http2.ErrCodeInternal,
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName(tableID)},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowsOp(t, server, &req, nil)
+
+	// 4a. Verify that all the stored rows were read successfully
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRows, len(res.GetRows()))
+	for i, row := range res.GetRows() {
+		assert.Equal(t, "row-"+strconv.Itoa(i), string(row.Key))
+	}
+
+	// 4b. Verify that the retry request excluded the row that had been received
+	assert.Equal(t, 2, len(recorder))
+	<-recorder
+	retryReq := <-recorder
+	assert.True(t, cmp.Equal(retryReq.req.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-0")}))
+}
+
+// TestReadRows_Retry_GoAwayMidStream tests that client will reconnect and resume the scan after the
+// rows received before the connection is closed with GOAWAY.
+func TestReadRows_Retry_GoAwayMidStream(t *testing.T) {
+	// 0. Common variables
+	const tableID string = "table"
+	const numRows int = 3
+
+	// 1. Instantiate the mock server, whose table has three rows. The connection will be closed
+	// after one row of the first attempt is sent.
+	server := initMockServerWithStore(t, tableID, []string{"f"})
+	if _, err := server.store.mutateRows(context.Background(), dummyMutateRowsRequest(tableID, numRows)); err != nil {
+		t.Fatalf("Failed to write the rows to the store: %v", err)
+	}
+	recorder := make(chan *readRowsReqRecord, 2)
+	server.ReadRowsFn = mockReadRowsFnWithStore(server.store, recorder)
+	server.injectTransportFaults(t, &transportFault{
+		kind:          goAway,
+		method:        "ReadRows",
+		attempt:       1,
+		afterMessages: 1,
+		errCode:       http2.ErrCodeNo,
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName(tableID)},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowsOp(t, server, &req, nil)
+
+	// 4a. Verify that all the stored rows were read successfully
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRows, len(res.GetRows()))
+	for i, row := range res.GetRows() {
+		assert.Equal(t, "row-"+strconv.Itoa(i), string(row.Key))
+	}
+
+	// 4b. Verify that the retry request excluded the row that had been received
+	assert.Equal(t, 2, len(recorder))
+	<-recorder
+	retryReq := <-recorder
+	assert.True(t, cmp.Equal(retryReq.req.GetRows().GetRowRanges()[0].StartKey, &btpb.RowRange_StartKeyOpen{StartKeyOpen: []byte("row-0")}))
+}
+
+// TestReadRows_Generic_SecureConnection tests that client can read rows from a TLS-enabled server
+// whose certificate is issued by the CA in the client settings.
+func TestReadRows_Generic_SecureConnection(t *testing.T) {
+	// 1. Instantiate the mock server
+	recorder := make(chan *readRowsReqRecord, 1)
+	action := &readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}}
+	server := initMockServerWithTLS(t, nil)
+	server.ReadRowsFn = mockReadRowsFnSimple(recorder, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowsOp(t, server, &req, tlsClientOpts(server, ""))
+
+	// 4. Check that the operation succeeded over the secure connection
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 1, len(res.GetRows()))
+	assert.Equal(t, 1, len(recorder))
+}
+
+// TestReadRows_Generic_SecureConnectionEndpointOverride tests that client verifies the server
+// certificate against the overridden SSL endpoint instead of the data target.
+func TestReadRows_Generic_SecureConnectionEndpointOverride(t *testing.T) {
+	// 0. Common variables
+	const sslEndpoint string = "bigtable.test.example"
+
+	// 1. Instantiate the mock server, whose certificate is only valid for `sslEndpoint`
+	recorder := make(chan *readRowsReqRecord, 1)
+	action := &readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}}
+	server := initMockServerWithTLS(t, []string{sslEndpoint})
+	server.ReadRowsFn = mockReadRowsFnSimple(recorder, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doReadRowsOp(t, server, &req, tlsClientOpts(server, sslEndpoint))
+
+	// 4. Check that the operation succeeded over the secure connection
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 1, len(res.GetRows()))
+	assert.Equal(t, 1, len(recorder))
+}
+
+// TestReadRows_Generic_SecureConnectionUntrustedCert tests that client refuses to talk to a
+// TLS-enabled server whose certificate is issued by a CA that the client doesn't trust.
+func TestReadRows_Generic_SecureConnectionUntrustedCert(t *testing.T) {
+	// 1. Instantiate the mock server
+	recorder := make(chan *readRowsReqRecord, 1)
+	action := &readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}}
+	server := initMockServerWithTLS(t, nil)
+	server.ReadRowsFn = mockReadRowsFnSimple(recorder, action)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy, with a client that trusts a different CA
+	untrustedCA, err := newTestCA()
+	if err != nil {
+		t.Fatalf("CA generation failed: %v", err)
+	}
+	opts := tlsClientOpts(server, "")
+	opts.security.SslRootCertsPem = untrustedCA.certPEM
+	opts.timeout = &durationpb.Duration{Seconds: 5}
+	res := doReadRowsOp(t, server, &req, opts)
+
+	// 4. Check that the operation failed without reaching the server
+	assert.NotNil(t, res)
+	if res != nil {
+		assert.NotEqual(t, int32(codes.OK), res.GetStatus().GetCode())
+	}
+	assert.Equal(t, 0, len(recorder))
+}
+
+// TestReadRows_Retry_AccessTokenOnRetries tests that client attaches the access token to every
+// attempt, including the retries.
+func TestReadRows_Retry_AccessTokenOnRetries(t *testing.T) {
+	// 0. Common variables
+	const accessToken string = "test-token"
+
+	// 1. Instantiate the mock server, which rejects the requests without the token
+	recorder := make(chan *readRowsReqRecord, 2)
+	sequence := []*readRowsAction{
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-02", "v2", Commit)}},
+	}
+	server := initMockServerWithTLS(t, nil)
+	server.ReadRowsFn = mockReadRowsFn(recorder, sequence)
+	server.requireAccessToken(accessToken)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := tlsClientOpts(server, "")
+	opts.security.AccessToken = accessToken
+	res := doReadRowsOp(t, server, &req, opts)
+
+	// 4a. Check that the operation succeeded after the retry
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 2, len(res.GetRows()))
+	assert.Equal(t, 2, len(recorder))
+
+	// 4b. Check that every attempt carried the token
+	authRecords := server.authRecords()
+	assert.Equal(t, 2, len(authRecords))
+	for _, record := range authRecords {
+		assert.Equal(t, "Bearer "+accessToken, record.authorization, "%s request", record.method)
+	}
+}
+
+// TestReadRows_Generic_RecordAndReplay tests that the recorded traffic of a retried ReadRows is
+// served back in replay mode, while the mock function still gets the new requests.
+func TestReadRows_Generic_RecordAndReplay(t *testing.T) {
+	if *recordDir != "" || *replayDir != "" {
+		t.Skip("The test records and replays the traffic on its own")
+	}
+
+	// 0. Common variables
+	file := filepath.Join(t.TempDir(), "traffic.json")
+	req := testproxypb.ReadRowsRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.ReadRowsRequest{TableName: buildTableName("table")},
+	}
+
+	// 1. Record the traffic of a ReadRows that is retried once
+	recorder := make(chan *readRowsReqRecord, 2)
+	server := initMockServer(t)
+	server.ReadRowsFn = mockReadRowsFn(recorder, []*readRowsAction{
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-01", "v1", Commit)}},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-02", "v2", Commit)}},
+	})
+	server.recordTraffic(t.Name())
+	res := doReadRowsOp(t, server, &req, nil)
+	checkResultOkStatus(t, res)
+	assert.Equal(t, 2, len(recorder))
+	if err := server.saveTraffic(file); err != nil {
+		t.Fatalf("Failed to save the recording: %v", err)
+	}
+
+	// 2. Replay the traffic on a mock server whose mock function would return other rows
+	replayRecorder := make(chan *readRowsReqRecord, 2)
+	replayServer := initMockServer(t)
+	replayServer.ReadRowsFn = mockReadRowsFn(replayRecorder, []*readRowsAction{
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-03", "v3", Commit)}},
+		&readRowsAction{rpcError: codes.Unavailable},
+		&readRowsAction{chunks: []chunkData{dummyChunkData("row-04", "v4", Commit)}},
+	})
+	if err := replayServer.replayTraffic(file); err != nil {
+		t.Fatalf("Failed to load the recording: %v", err)
+	}
+	replayRes := doReadRowsOp(t, replayServer, &req, nil)
+
+	// 3. Check that the recorded rows were served, and the new requests matched the recorded ones
+	// and reached the mock function
+	checkResultOkStatus(t, replayRes)
+	if assert.Equal(t, 2, len(replayRes.GetRows())) {
+		assert.Equal(t, "row-01", string(replayRes.GetRows()[0].GetKey()))
+		assert.Equal(t, "row-02", string(replayRes.GetRows()[1].GetKey()))
+	}
+	assert.Empty(t, replayServer.trafficMismatches())
+	assert.Equal(t, 2, len(replayRecorder))
+	<-replayRecorder
+	loggedRetry := <-replayRecorder
+	if assert.Equal(t, 1, len(loggedRetry.req.GetRows().GetRowRanges())) {
+		assert.Equal(t, "row-01", string(loggedRetry.req.GetRows().GetRowRanges()[0].GetStartKeyOpen()))
+	}
+}
@@ -18,8 +18,10 @@
 package tests
 
 import (
+	"fmt"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -28,7 +30,6 @@ import (
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/testing/protocmp"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -41,12 +42,9 @@ func TestSampleRowKeys_Generic_Headers(t *testing.T) {
 	tableName := buildTableName("table")
 
 	// 1. Instantiate the mock server
-	// Don't call mockSampleRowKeysFn() as the behavior is to record metadata of the request
-	mdRecords := make(chan metadata.MD, 1)
+	// The server records the metadata of every request, so the mock function only responds
 	server := initMockServer(t)
 	server.SampleRowKeysFn = func(req *btpb.SampleRowKeysRequest, srv btpb.Bigtable_SampleRowKeysServer) error {
-		md, _ := metadata.FromIncomingContext(srv.Context())
-		mdRecords <- md
 		return nil
 	}
 
@@ -63,7 +61,7 @@ func TestSampleRowKeys_Generic_Headers(t *testing.T) {
 	doSampleRowKeysOp(t, server, &req, &opts)
 
 	// 4. Check the request headers in the metadata
-	md := <-mdRecords
+	md := requireCallRecords(t, server, "SampleRowKeys", "", 1)[0].md
 	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
 		assert.Fail(t, "Client info is missing in the request header")
 	}
@@ -75,6 +73,73 @@ func TestSampleRowKeys_Generic_Headers(t *testing.T) {
 	assert.Contains(t, resource, profileID)
 }
 
+// TestSampleRowKeys_Retry_Headers tests that every attempt of SampleRowKeys, including the retries,
+// has the client and resource info, an incrementing attempt counter and a stable operation ID.
+func TestSampleRowKeys_Retry_Headers(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	const profileID string = "test_profile"
+	tableName := buildTableName("table")
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.SampleRowKeysFn = mockSampleRowKeysFn(nil, []sampleRowKeysAction{
+		sampleRowKeysAction{rpcError: codes.Unavailable},
+		sampleRowKeysAction{rpcError: codes.Unavailable},
+		sampleRowKeysAction{rowKey: []byte("row-31"), offsetBytes: 30},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.SampleRowKeysRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.SampleRowKeysRequest{TableName: tableName},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		profile: profileID,
+	}
+	res := doSampleRowKeysOp(t, server, &req, &opts)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+
+	// 4b. Check the headers of every attempt
+	assert.Equal(t, numRPCs, checkAttemptHeaders(t, server, "SampleRowKeys", "", attemptHeaders{
+		params: map[string]string{"table_name": tableName, "app_profile_id": profileID},
+	}))
+}
+
+// TestSampleRowKeys_Retry_TraceContext tests that every attempt of SampleRowKeys, including the
+// retries, propagates the trace of the operation in a span of its own.
+func TestSampleRowKeys_Retry_TraceContext(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 3
+	parent := newTraceContext()
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.SampleRowKeysFn = mockSampleRowKeysFn(nil, []sampleRowKeysAction{
+		sampleRowKeysAction{rpcError: codes.Unavailable},
+		sampleRowKeysAction{rpcError: codes.Unavailable},
+		sampleRowKeysAction{rowKey: []byte("row-31"), offsetBytes: 30},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.SampleRowKeysRequest{
+		ClientId:    t.Name(),
+		Request:     &btpb.SampleRowKeysRequest{TableName: buildTableName("table")},
+		Traceparent: parent.traceparent(),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doSampleRowKeysOp(t, server, &req, nil)
+
+	// 4. Check that the operation succeeded, and every attempt is a span in the trace
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRPCs, len(checkAttemptTraces(t, server, "SampleRowKeys", "", parent)))
+}
+
 // TestSampleRowKeys_NoRetry_NoEmptyKey tests that client should accept a list with no empty key.
 func TestSampleRowKeys_NoRetry_NoEmptyKey(t *testing.T) {
 	// 0. Common variables
@@ -150,6 +215,104 @@ func TestSampleRowKeys_Generic_MultiStreams(t *testing.T) {
 	checkRequestsAreWithin(t, 1000, recorder)
 }
 
+// TestSampleRowKeys_Generic_MultiStreams_PerTable tests that client can have multiple concurrent
+// streams on different tables, and each stream gets the samples of its own table.
+func TestSampleRowKeys_Generic_MultiStreams_PerTable(t *testing.T) {
+	// 0. Common variables
+	const concurrency = 3
+
+	// 1. Instantiate the mock server
+	// The requests are routed by table name, as they cannot be differentiated by row keys.
+	recorder := make(chan *sampleRowKeysReqRecord, concurrency)
+	fns := make(map[string]func(*btpb.SampleRowKeysRequest, btpb.Bigtable_SampleRowKeysServer) error)
+	for i := 0; i < concurrency; i++ {
+		fns[buildTableName(fmt.Sprintf("table%d", i))] = mockSampleRowKeysFn(recorder, []sampleRowKeysAction{
+			sampleRowKeysAction{rowKey: []byte(fmt.Sprintf("row-%d", i)), offsetBytes: int64(i), endOfStream: true, delayStr: "2s"},
+		})
+	}
+	server := initMockServer(t)
+	server.SampleRowKeysFn = routeStreamFn("SampleRowKeys", routeByTableName, fns)
+
+	// 2. Build the requests to test proxy
+	reqs := make([]*testproxypb.SampleRowKeysRequest, concurrency)
+	for i := 0; i < concurrency; i++ {
+		reqs[i] = &testproxypb.SampleRowKeysRequest{
+			ClientId: t.Name(),
+			Request:  &btpb.SampleRowKeysRequest{TableName: buildTableName(fmt.Sprintf("table%d", i))},
+		}
+	}
+
+	// 3. Perform the operations via test proxy
+	results := doSampleRowKeysOps(t, server, reqs, nil)
+
+	// 4a. Check that all the requests succeeded with the samples of their tables
+	assert.Equal(t, concurrency, len(results))
+	checkResultOkStatus(t, results...)
+	for i, res := range results {
+		if assert.Equal(t, 1, len(res.GetSamples())) {
+			assert.Equal(t, fmt.Sprintf("row-%d", i), string(res.GetSamples()[0].GetRowKey()))
+			assert.Equal(t, int64(i), res.GetSamples()[0].GetOffsetBytes())
+		}
+	}
+
+	// 4b. Check that the timestamps of requests should be very close
+	assert.Equal(t, concurrency, len(recorder))
+	checkRequestsAreWithin(t, 1000, recorder)
+}
+
+// TestSampleRowKeys_Generic_MultiStreams_PerAppProfile tests that clients with different app
+// profiles can have concurrent streams on the same table, and each stream gets its own response.
+func TestSampleRowKeys_Generic_MultiStreams_PerAppProfile(t *testing.T) {
+	// 0. Common variables
+	profiles := []string{"profile0", "profile1"}
+	clientIDs := []string{t.Name() + "_0", t.Name() + "_1"}
+
+	// 1. Instantiate the mock server
+	// The requests are routed by app profile, as they only differ in the app profile.
+	server := initMockServer(t)
+	server.SampleRowKeysFn = routeStreamFn("SampleRowKeys", routeByAppProfile,
+		map[string]func(*btpb.SampleRowKeysRequest, btpb.Bigtable_SampleRowKeysServer) error{
+			profiles[0]: mockSampleRowKeysFn(nil, []sampleRowKeysAction{
+				sampleRowKeysAction{rowKey: []byte("row-31"), offsetBytes: 30, endOfStream: true, delayStr: "1s"},
+			}),
+			profiles[1]: mockSampleRowKeysFn(nil, []sampleRowKeysAction{
+				sampleRowKeysAction{rpcError: codes.PermissionDenied, delayStr: "1s"},
+			}),
+		})
+
+	// 2. Create the clients and build the requests to test proxy
+	server.Start()
+	defer server.Close()
+	for i, clientID := range clientIDs {
+		createCbtClient(t, clientID, server.Addr, &clientOpts{profile: profiles[i]})
+		defer removeCbtClient(t, clientID)
+		defer closeCbtClient(t, clientID)
+	}
+
+	// 3. Perform the operations via test proxy concurrently
+	results := make([]*testproxypb.SampleRowKeysResult, len(clientIDs))
+	var wg sync.WaitGroup
+	for i, clientID := range clientIDs {
+		wg.Add(1)
+		go func(i int, clientID string) {
+			defer wg.Done()
+			req := &testproxypb.SampleRowKeysRequest{
+				ClientId: clientID,
+				Request:  &btpb.SampleRowKeysRequest{TableName: buildTableName("table")},
+			}
+			results[i] = doSampleRowKeysOpsCore(t, clientID, []*testproxypb.SampleRowKeysRequest{req}, nil)[0]
+		}(i, clientID)
+	}
+	wg.Wait()
+
+	// 4. Check that each client gets the response for its app profile
+	checkResultOkStatus(t, results[0])
+	if assert.Equal(t, 1, len(results[0].GetSamples())) {
+		assert.Equal(t, "row-31", string(results[0].GetSamples()[0].GetRowKey()))
+	}
+	assert.Equal(t, int32(codes.PermissionDenied), results[1].GetStatus().GetCode())
+}
+
 // TestSampleRowKeys_Generic_CloseClient tests that client doesn't kill inflight requests after
 // client closing, but will reject new requests.
 func TestSampleRowKeys_Generic_CloseClient(t *testing.T) {
@@ -240,6 +403,75 @@ func TestSampleRowKeys_Generic_DeadlineExceeded(t *testing.T) {
 
 	// 4b. Check the DeadlineExceeded error
 	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4c. Check the deadline of the attempt
+	checkAttemptDeadlines(t, server, "SampleRowKeys", "", 2*time.Second)
+}
+
+// TestSampleRowKeys_Retry_DeadlineExceeded tests that client sends what is left of the timeout
+// in the grpc-timeout of every attempt, and stops retrying once the timeout is reached.
+func TestSampleRowKeys_Retry_DeadlineExceeded(t *testing.T) {
+	// 0. Common variables
+	const maxRPCs int = 10 // More than the attempts that fit in the timeout.
+	const timeout = 2 * time.Second
+
+	// 1. Instantiate the mock server
+	var actions []sampleRowKeysAction
+	for i := 0; i < maxRPCs; i++ {
+		actions = append(actions, sampleRowKeysAction{rpcError: codes.Unavailable, delayStr: "500ms"})
+	}
+	server := initMockServer(t)
+	server.SampleRowKeysFn = mockSampleRowKeysFn(nil, actions)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.SampleRowKeysRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.SampleRowKeysRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: durationpb.New(timeout),
+	}
+	res := doSampleRowKeysOp(t, server, &req, &opts)
+
+	// 4a. Check the DeadlineExceeded error
+	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+
+	// 4b. Check the deadlines of the attempts, and that the client did retry
+	assert.Greater(t, checkAttemptDeadlines(t, server, "SampleRowKeys", "", timeout), 1)
+}
+
+// TestSampleRowKeys_Retry_ExponentialBackoff tests that client will retry using exponential
+// backoff, within the bounds of -backoff_profile.
+func TestSampleRowKeys_Retry_ExponentialBackoff(t *testing.T) {
+	// 0. Common variables
+	const numRPCs int = 4
+
+	// 1. Instantiate the mock server
+	server := initMockServer(t)
+	server.SampleRowKeysFn = mockSampleRowKeysFn(nil, []sampleRowKeysAction{
+		sampleRowKeysAction{rpcError: codes.Unavailable},
+		sampleRowKeysAction{rpcError: codes.Unavailable},
+		sampleRowKeysAction{rpcError: codes.Unavailable},
+		sampleRowKeysAction{rowKey: []byte("row-01"), offsetBytes: 100},
+	})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.SampleRowKeysRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.SampleRowKeysRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doSampleRowKeysOp(t, server, &req, nil)
+
+	// 4a. Check that the operation succeeded after the retries
+	checkResultOkStatus(t, res)
+	assert.Equal(t, numRPCs, len(server.callRecords("SampleRowKeys", "")))
+
+	// 4b. Check the retry delays
+	checkRetryBackoff(t, server, "SampleRowKeys", "")
 }
 
 // TestSampleRowKeys_Retry_WithRoutingCookie tests that client handles routing cookie correctly.
@@ -249,14 +481,12 @@ func TestSampleRowKeys_Retry_WithRoutingCookie(t *testing.T) {
 	clientReq := &btpb.SampleRowKeysRequest{TableName: buildTableName("table")}
 
 	// 1. Instantiate the mock server
-	recorder := make(chan *sampleRowKeysReqRecord, 2)
-	mdRecorder := make(chan metadata.MD, 2)
 	sequence := []sampleRowKeysAction{
 		sampleRowKeysAction{rpcError: codes.Unavailable, routingCookie: cookie},
 		sampleRowKeysAction{rowKey: []byte("row-31"), offsetBytes: 30},
 	}
 	server := initMockServer(t)
-	server.SampleRowKeysFn = mockSampleRowKeysFnWithMetadata(recorder, mdRecorder, sequence)
+	server.SampleRowKeysFn = mockSampleRowKeysFn(nil, sequence)
 
 	// 2. Build the request to test proxy
 	req := testproxypb.SampleRowKeysRequest{
@@ -273,11 +503,9 @@ func TestSampleRowKeys_Retry_WithRoutingCookie(t *testing.T) {
 	assert.Equal(t, "row-31", string(res.GetSamples()[0].RowKey))
 
 	// 4b. Verify routing cookie is seen
-	// Ignore the first metadata which won't have the routing cookie
-	var _ = <-mdRecorder
-	// second metadata which comes from the retry attempt should have a routing cookie field
-	md1 := <-mdRecorder
-	val := md1["x-goog-cbt-cookie-test"]
+	// The first attempt won't have the routing cookie, and the retry attempt should have it
+	records := requireCallRecords(t, server, "SampleRowKeys", "", 2)
+	val := records[1].md["x-goog-cbt-cookie-test"]
 	assert.NotEmpty(t, val)
 	if len(val) == 0 {
 		return
@@ -290,13 +518,12 @@ func TestSampleRowKeys_Retry_WithRetryInfo(t *testing.T) {
 
 	// 1. Instantiate the mock server
 	recorder := make(chan *sampleRowKeysReqRecord, 2)
-	mdRecorder := make(chan metadata.MD, 2)
 	sequence := []sampleRowKeysAction{
 		sampleRowKeysAction{rpcError: codes.Unavailable, retryInfo: "2s"},
 		sampleRowKeysAction{rowKey: []byte("row-31"), offsetBytes: 30},
 	}
 	server := initMockServer(t)
-	server.SampleRowKeysFn = mockSampleRowKeysFnWithMetadata(recorder, mdRecorder, sequence)
+	server.SampleRowKeysFn = mockSampleRowKeysFn(recorder, sequence)
 
 	// 2. Build the request to test proxy
 	clientReq := &btpb.SampleRowKeysRequest{TableName: buildTableName("table")}
@@ -323,3 +550,30 @@ func TestSampleRowKeys_Retry_WithRetryInfo(t *testing.T) {
 		t.Error("Timeout waiting for retry request")
 	}
 }
+
+// TestSampleRowKeys_Generic_SecureConnection tests that client can sample row keys from a
+// TLS-enabled server.
+func TestSampleRowKeys_Generic_SecureConnection(t *testing.T) {
+	// 1. Instantiate the mock server
+	recorder := make(chan *sampleRowKeysReqRecord, 1)
+	sequence := []sampleRowKeysAction{
+		sampleRowKeysAction{rowKey: []byte("row-31"), offsetBytes: 30},
+		sampleRowKeysAction{rowKey: []byte(""), offsetBytes: 65},
+	}
+	server := initMockServerWithTLS(t, nil)
+	server.SampleRowKeysFn = mockSampleRowKeysFn(recorder, sequence)
+
+	// 2. Build the request to test proxy
+	req := testproxypb.SampleRowKeysRequest{
+		ClientId: t.Name(),
+		Request:  &btpb.SampleRowKeysRequest{TableName: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doSampleRowKeysOp(t, server, &req, tlsClientOpts(server, ""))
+
+	// 4. Check that the operation succeeded over the secure connection, and reached the server
+	checkResultOkStatus(t, res)
+	records := requireCallRecords(t, server, "SampleRowKeys", "", 1)
+	assert.Equal(t, req.Request.TableName, records[0].req.(*btpb.SampleRowKeysRequest).GetTableName())
+}
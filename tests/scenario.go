@@ -0,0 +1,357 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the data-driven scenarios, which describe a test in JSON
+// instead of Go: the scripted attempts of the mock server, the requests to the
+// test proxy, and the expected results and server requests. See
+// docs/test_case_writing.md for the format, and testdata/scenarios for the
+// samples.
+package tests
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	gs "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// scenario is a test described in a JSON file. The messages are in the JSON format of their
+// protos, see https://protobuf.dev/programming-guides/json/.
+type scenario struct {
+	Description string `json:"description"`
+
+	// Method is the method of the test proxy to call, e.g., "ReadRows" or "BulkMutateRows".
+	Method string `json:"method"`
+
+	// Client contains the settings of the client created in the test proxy.
+	Client struct {
+		AppProfileID string `json:"appProfileId"`
+		Timeout      string `json:"timeout"` // "" means the default; follow https://pkg.go.dev/time#ParseDuration otherwise.
+	} `json:"client"`
+
+	// Server maps the methods of the mock server, e.g., "ReadRows", to the attempts they serve in
+	// order. The methods not in the map are unimplemented.
+	Server map[string][]*scenarioAttempt `json:"server"`
+
+	// Requests are the requests to the test proxy, which are sent concurrently. Their client_id
+	// is set by the runner.
+	Requests []json.RawMessage `json:"requests"`
+
+	Expect struct {
+		// Results are the expected results of the requests, where the i-th result corresponds to the
+		// i-th request. Only the code of the status is compared. Empty means no check.
+		Results []json.RawMessage `json:"results"`
+		// ServerRequests maps the methods of the mock server to the requests they are expected to
+		// receive in time order. Only the top-level fields set in the expected requests are
+		// compared, and the methods not in the map aren't checked.
+		ServerRequests map[string][]json.RawMessage `json:"serverRequests"`
+	} `json:"expect"`
+}
+
+// scenarioAttempt tells the mock server how to serve a request in a scenario.
+type scenarioAttempt struct {
+	Delay         string            `json:"delay"`         // The delay before the responses; "" means zero delay.
+	Responses     []json.RawMessage `json:"responses"`     // Unary methods return the first one, or an empty response.
+	Error         codes.Code        `json:"error"`         // The status code after the responses, e.g., "UNAVAILABLE".
+	RetryInfo     string            `json:"retryInfo"`     // "" means no RetryInfo will be attached in the error status.
+	RoutingCookie string            `json:"routingCookie"` // Sent in the trailer of the error.
+}
+
+// loadScenario reads the scenario from `file`.
+func loadScenario(file string) (*scenario, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var sc scenario
+	if err := json.Unmarshal(content, &sc); err != nil {
+		return nil, fmt.Errorf("invalid scenario %s: %v", file, err)
+	}
+	if len(sc.Requests) == 0 {
+		return nil, fmt.Errorf("invalid scenario %s: no requests", file)
+	}
+	if len(sc.Expect.Results) != 0 && len(sc.Expect.Results) != len(sc.Requests) {
+		return nil, fmt.Errorf("invalid scenario %s: %d expected results for %d requests", file, len(sc.Expect.Results), len(sc.Requests))
+	}
+	return &sc, nil
+}
+
+// clientOpts returns the client settings of the scenario.
+func (sc *scenario) clientOpts() (*clientOpts, error) {
+	opts := &clientOpts{profile: sc.Client.AppProfileID}
+	if sc.Client.Timeout != "" {
+		timeout, err := time.ParseDuration(sc.Client.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("invalid client timeout: %v", err)
+		}
+		opts.timeout = durationpb.New(timeout)
+	}
+	return opts, nil
+}
+
+// responses decodes the responses of the attempt as messages of the same type as `res`.
+func (a *scenarioAttempt) responses(method string, res proto.Message) ([]proto.Message, error) {
+	var msgs []proto.Message
+	for _, raw := range a.Responses {
+		msg := res.ProtoReflect().New().Interface()
+		if err := protojson.Unmarshal(raw, msg); err != nil {
+			return nil, gs.Errorf(codes.Internal, "invalid %s response in the scenario: %v", method, err)
+		}
+		msgs = append(msgs, msg)
+	}
+	return msgs, nil
+}
+
+// attemptQueue returns the attempts as a queue for the mock server to consume.
+func attemptQueue(attempts []*scenarioAttempt) chan *scenarioAttempt {
+	queue := make(chan *scenarioAttempt, len(attempts))
+	for _, attempt := range attempts {
+		queue <- attempt
+	}
+	close(queue)
+	return queue
+}
+
+// exhaustedError returns the error for a request of `method` that has no attempt left to serve it.
+func exhaustedError(method string) error {
+	return gs.Errorf(codes.Internal, "%s failed: no attempt left in the scenario", method)
+}
+
+// scenarioUnaryFn returns a mock implementation of a unary method, e.g., MutateRow, which serves
+// the requests with `attempts` in order.
+func scenarioUnaryFn[Req proto.Message, Res proto.Message](method string, attempts []*scenarioAttempt) func(context.Context, Req) (Res, error) {
+	queue := attemptQueue(attempts)
+	return func(ctx context.Context, req Req) (Res, error) {
+		var none Res
+		attempt, more := <-queue
+		if !more {
+			return none, exhaustedError(method)
+		}
+		sleepFor(attempt.Delay)
+
+		if attempt.Error != codes.OK {
+			if attempt.RoutingCookie != "" {
+				// add routing cookie to metadata
+				trailer := metadata.Pairs("x-goog-cbt-cookie-test", attempt.RoutingCookie)
+				if err := grpc.SetTrailer(ctx, trailer); err != nil {
+					return none, err
+				}
+			}
+			return none, buildActionError(method, attempt.Error, attempt.RetryInfo)
+		}
+
+		msgs, err := attempt.responses(method, none)
+		if err != nil {
+			return none, err
+		}
+		if len(msgs) == 0 {
+			return none.ProtoReflect().New().Interface().(Res), nil
+		}
+		return msgs[0].(Res), nil
+	}
+}
+
+// scenarioStreamFn is the variant of scenarioUnaryFn() for the server-streaming methods, e.g.,
+// ReadRows. The error of an attempt is returned after its responses.
+func scenarioStreamFn[Req proto.Message, Res proto.Message, Srv interface {
+	Send(Res) error
+	SetTrailer(metadata.MD)
+}](method string, attempts []*scenarioAttempt) func(Req, Srv) error {
+	queue := attemptQueue(attempts)
+	return func(req Req, srv Srv) error {
+		attempt, more := <-queue
+		if !more {
+			return exhaustedError(method)
+		}
+		sleepFor(attempt.Delay)
+
+		var none Res
+		msgs, err := attempt.responses(method, none)
+		if err != nil {
+			return err
+		}
+		for _, msg := range msgs {
+			if err := srv.Send(msg.(Res)); err != nil {
+				return err
+			}
+		}
+
+		if attempt.Error != codes.OK {
+			if attempt.RoutingCookie != "" {
+				// add routing cookie to metadata
+				trailer := metadata.Pairs("x-goog-cbt-cookie-test", attempt.RoutingCookie)
+				srv.SetTrailer(trailer)
+			}
+			return buildActionError(method, attempt.Error, attempt.RetryInfo)
+		}
+		return nil
+	}
+}
+
+// setUpScenarioServer makes the mock server `s` serve the attempts of the scenario `sc`.
+func setUpScenarioServer(s *Server, sc *scenario) error {
+	for method, attempts := range sc.Server {
+		switch method {
+		case "ReadRows":
+			s.ReadRowsFn = scenarioStreamFn[*btpb.ReadRowsRequest, *btpb.ReadRowsResponse, btpb.Bigtable_ReadRowsServer](method, attempts)
+		case "SampleRowKeys":
+			s.SampleRowKeysFn = scenarioStreamFn[*btpb.SampleRowKeysRequest, *btpb.SampleRowKeysResponse, btpb.Bigtable_SampleRowKeysServer](method, attempts)
+		case "MutateRow":
+			s.MutateRowFn = scenarioUnaryFn[*btpb.MutateRowRequest, *btpb.MutateRowResponse](method, attempts)
+		case "MutateRows":
+			s.MutateRowsFn = scenarioStreamFn[*btpb.MutateRowsRequest, *btpb.MutateRowsResponse, btpb.Bigtable_MutateRowsServer](method, attempts)
+		case "CheckAndMutateRow":
+			s.CheckAndMutateRowFn = scenarioUnaryFn[*btpb.CheckAndMutateRowRequest, *btpb.CheckAndMutateRowResponse](method, attempts)
+		case "ReadModifyWriteRow":
+			s.ReadModifyWriteRowFn = scenarioUnaryFn[*btpb.ReadModifyWriteRowRequest, *btpb.ReadModifyWriteRowResponse](method, attempts)
+		case "PrepareQuery":
+			s.PrepareQueryFn = scenarioUnaryFn[*btpb.PrepareQueryRequest, *btpb.PrepareQueryResponse](method, attempts)
+		case "ExecuteQuery":
+			s.ExecuteQueryFn = scenarioStreamFn[*btpb.ExecuteQueryRequest, *btpb.ExecuteQueryResponse, btpb.Bigtable_ExecuteQueryServer](method, attempts)
+		case "ReadChangeStream":
+			s.ReadChangeStreamFn = scenarioStreamFn[*btpb.ReadChangeStreamRequest, *btpb.ReadChangeStreamResponse, btpb.Bigtable_ReadChangeStreamServer](method, attempts)
+		case "PingAndWarm":
+			s.PingAndWarmFn = scenarioUnaryFn[*btpb.PingAndWarmRequest, *btpb.PingAndWarmResponse](method, attempts)
+		default:
+			return fmt.Errorf("unsupported server method %q", method)
+		}
+	}
+	return nil
+}
+
+// runScenario runs the scenario `sc` against the mock server `s`, checks the expectations, and
+// returns the outcome for the comparison with the other proxies.
+func runScenario(t *testing.T, s *Server, sc *scenario) *scenarioOutcome {
+	if err := setUpScenarioServer(s, sc); err != nil {
+		t.Fatal(err)
+	}
+	opts, err := sc.clientOpts()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var results []proto.Message
+	switch sc.Method {
+	case "ReadRow":
+		results = runScenarioOps(t, s, sc, opts, doReadRowOps)
+	case "ReadRows":
+		results = runScenarioOps(t, s, sc, opts, doReadRowsOps)
+	case "MutateRow":
+		results = runScenarioOps(t, s, sc, opts, doMutateRowOps)
+	case "BulkMutateRows":
+		results = runScenarioOps(t, s, sc, opts, doMutateRowsOps)
+	case "SampleRowKeys":
+		results = runScenarioOps(t, s, sc, opts, doSampleRowKeysOps)
+	case "CheckAndMutateRow":
+		results = runScenarioOps(t, s, sc, opts, doCheckAndMutateRowOps)
+	case "ReadModifyWriteRow":
+		results = runScenarioOps(t, s, sc, opts, doReadModifyWriteRowOps)
+	case "ExecuteQuery":
+		results = runScenarioOps(t, s, sc, opts, doExecuteQueryOps)
+	case "ReadChangeStream":
+		results = runScenarioOps(t, s, sc, opts, doReadChangeStreamOps)
+	default:
+		t.Fatalf("unsupported proxy method %q", sc.Method)
+	}
+
+	for method, raws := range sc.Expect.ServerRequests {
+		checkScenarioServerRequests(t, s, method, raws)
+	}
+	return newScenarioOutcome(s, results)
+}
+
+// runScenarioOps sends the requests of the scenario `sc` with `do`, e.g., doReadRowsOps, checks
+// the results, and returns them.
+func runScenarioOps[Req proto.Message, Res interface {
+	proto.Message
+	GetStatus() *status.Status
+}](t *testing.T, s *Server, sc *scenario, opts *clientOpts, do func(*testing.T, *Server, []Req, *clientOpts) []Res) []proto.Message {
+	reqs := make([]Req, len(sc.Requests))
+	for i, raw := range sc.Requests {
+		var none Req
+		req := none.ProtoReflect().New()
+		if err := protojson.Unmarshal(raw, req.Interface()); err != nil {
+			t.Fatalf("invalid request %d in the scenario: %v", i, err)
+		}
+		req.Set(req.Descriptor().Fields().ByName("client_id"), protoreflect.ValueOfString(t.Name()))
+		reqs[i] = req.Interface().(Req)
+	}
+
+	results := do(t, s, reqs, opts)
+
+	for i, raw := range sc.Expect.Results {
+		var none Res
+		want := none.ProtoReflect().New().Interface().(Res)
+		if err := protojson.Unmarshal(raw, want); err != nil {
+			t.Fatalf("invalid result %d in the scenario: %v", i, err)
+		}
+		got := results[i]
+		if !got.ProtoReflect().IsValid() {
+			t.Errorf("result %d: proxy failure", i)
+			continue
+		}
+		assert.Equal(t, want.GetStatus().GetCode(), got.GetStatus().GetCode(), "status code of result %d: %v", i, got.GetStatus())
+		if diff := cmp.Diff(want, got, protocmp.Transform(), protocmp.IgnoreFields(want, "status")); diff != "" {
+			t.Errorf("result %d mismatch (-want +got):\n%s", i, diff)
+		}
+	}
+
+	msgs := make([]proto.Message, len(results))
+	for i, res := range results {
+		msgs[i] = res
+	}
+	return msgs
+}
+
+// checkScenarioServerRequests checks that the mock server `s` received the requests `raws` of
+// `method` in order. Only the top-level fields set in `raws` are compared.
+func checkScenarioServerRequests(t *testing.T, s *Server, method string, raws []json.RawMessage) {
+	records := s.callRecords(method, "")
+	if !assert.Len(t, records, len(raws), "number of %s requests", method) {
+		return
+	}
+	for i, raw := range raws {
+		want := records[i].req.ProtoReflect().New().Interface()
+		if err := protojson.Unmarshal(raw, want); err != nil {
+			t.Fatalf("invalid %s request %d in the scenario: %v", method, i, err)
+		}
+		// Clients may set optional fields differently, so only the fields in `want` are compared.
+		got := proto.Clone(records[i].req)
+		fields := got.ProtoReflect().Descriptor().Fields()
+		for j := 0; j < fields.Len(); j++ {
+			if !want.ProtoReflect().Has(fields.Get(j)) {
+				got.ProtoReflect().Clear(fields.Get(j))
+			}
+		}
+		if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+			t.Errorf("%s request %d mismatch (-want +got):\n%s", method, i, diff)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the behavioral diff of the clients, when a scenario runs
+// against several test proxies. Each client may pass the expectations of the
+// scenario, which are often partial, while still behaving differently from the
+// others, e.g., by retrying once more or by setting a different field in its
+// requests. The diff compares everything the clients did against the first
+// proxy: the final statuses, the returned results, the number of attempts and
+// the requests received by the mock server.
+package tests
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+)
+
+// scenarioOutcome is what a client did in a scenario.
+type scenarioOutcome struct {
+	results  []proto.Message            // The results of the requests, invalid for a proxy failure.
+	requests map[string][]proto.Message // The requests received by the mock server per method, in time order.
+}
+
+// newScenarioOutcome returns the outcome of a scenario with `results` against the mock server `s`.
+func newScenarioOutcome(s *Server, results []proto.Message) *scenarioOutcome {
+	outcome := &scenarioOutcome{results: results, requests: make(map[string][]proto.Message)}
+	for _, record := range s.callRecords("", "") {
+		outcome.requests[record.method] = append(outcome.requests[record.method], record.req)
+	}
+	return outcome
+}
+
+// resultStatus returns the status of a proxy result.
+func resultStatus(res proto.Message) *status.Status {
+	if r, ok := res.(interface{ GetStatus() *status.Status }); ok {
+		return r.GetStatus()
+	}
+	return nil
+}
+
+// diffScenarioOutcomes reports the differences between the outcomes of the proxies, where the i-th
+// outcome comes from the i-th proxy. Each outcome is compared against the first one, and the
+// proxies that failed to run the scenario, i.e., whose outcomes are nil, are skipped.
+func diffScenarioOutcomes(t *testing.T, proxies []*testProxy, outcomes []*scenarioOutcome) {
+	base := -1
+	for i, outcome := range outcomes {
+		if outcome == nil {
+			t.Logf("Proxy %s has no outcome to compare", proxies[i].name)
+			continue
+		}
+		if base < 0 {
+			base = i
+			continue
+		}
+		diffScenarioOutcome(t, proxies[base].name, outcomes[base], proxies[i].name, outcome)
+	}
+}
+
+// diffScenarioOutcome reports the differences of the outcome `got` of proxy `name` from the outcome
+// `want` of proxy `wantName`.
+func diffScenarioOutcome(t *testing.T, wantName string, want *scenarioOutcome, name string, got *scenarioOutcome) {
+	// 1. The final statuses and the returned results. Only the status codes are compared, as the
+	// messages are specific to the clients.
+	for i := range want.results {
+		w, g := want.results[i], got.results[i]
+		if !w.ProtoReflect().IsValid() || !g.ProtoReflect().IsValid() {
+			continue
+		}
+		if wc, gc := resultStatus(w).GetCode(), resultStatus(g).GetCode(); wc != gc {
+			t.Errorf("Result %d: %s returns status code %d, but %s returns %d", i, wantName, wc, name, gc)
+		}
+		if diff := cmp.Diff(w, g, protocmp.Transform(), protocmp.IgnoreFields(w, "status")); diff != "" {
+			t.Errorf("Result %d differs (-%s +%s):\n%s", i, wantName, name, diff)
+		}
+	}
+
+	// 2. The number of attempts and the requests of each method of the mock server.
+	methods := make(map[string]bool)
+	for method := range want.requests {
+		methods[method] = true
+	}
+	for method := range got.requests {
+		methods[method] = true
+	}
+	var sorted []string
+	for method := range methods {
+		sorted = append(sorted, method)
+	}
+	sort.Strings(sorted)
+	for _, method := range sorted {
+		w, g := want.requests[method], got.requests[method]
+		if len(w) != len(g) {
+			t.Errorf("%s makes %d %s attempts, but %s makes %d", wantName, len(w), method, name, len(g))
+		}
+		for i := 0; i < len(w) && i < len(g); i++ {
+			if diff := cmp.Diff(w[i], g[i], protocmp.Transform()); diff != "" {
+				t.Errorf("%s request %d differs (-%s +%s):\n%s", method, i, wantName, name, diff)
+			}
+		}
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !emulator
+// +build !emulator
+
+package tests
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestScenarios runs every JSON scenario file in -scenario_dir as a subtest named after the file.
+// If -proxy_addr has several proxies, each scenario runs against every proxy in a subtest named
+// after the proxy, and the "diff" subtest reports where the proxies behave differently. It then
+// only runs in the pass of the first proxy.
+func TestScenarios(t *testing.T) {
+	if passProxy > 0 {
+		t.Skip("The scenarios run against every proxy in the pass of the first one")
+	}
+	files, err := filepath.Glob(filepath.Join(*scenarioDir, "*.json"))
+	if err != nil {
+		t.Fatalf("Failed to list the scenarios in %s: %v", *scenarioDir, err)
+	}
+	if len(files) == 0 {
+		t.Skipf("No scenario is found in %s", *scenarioDir)
+	}
+
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(name, func(t *testing.T) {
+			// 0. Load the scenario
+			sc, err := loadScenario(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Log(sc.Description)
+
+			if len(proxies) <= 1 {
+				// 1. Instantiate the mock server
+				server := initMockServer(t)
+
+				// 2-4. Perform the operations via test proxy and check the expectations
+				runScenario(t, server, sc)
+				return
+			}
+
+			// 1-4. Run the scenario against every proxy with its own mock server
+			outcomes := make([]*scenarioOutcome, len(proxies))
+			for i, p := range proxies {
+				t.Run(p.name, func(t *testing.T) {
+					useProxy(t, p)
+					server := initMockServer(t)
+					outcomes[i] = runScenario(t, server, sc)
+				})
+			}
+
+			// 5. Compare the behaviors of the proxies
+			t.Run("diff", func(t *testing.T) {
+				diffScenarioOutcomes(t, proxies, outcomes)
+			})
+		})
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !emulator
+// +build !emulator
+
+package tests
+
+import (
+	"context"
+	"maps"
+	"net/url"
+	"slices"
+	"strings"
+	"testing"
+
+	adminpb "cloud.google.com/go/bigtable/admin/apiv2/adminpb"
+	"github.com/google/go-cmp/cmp"
+	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// dummyCreateTableRequest returns a dummy CreateTableRequest, where the table has the column
+// families `families` with default settings.
+func dummyCreateTableRequest(tableID string, families ...string) *adminpb.CreateTableRequest {
+	req := &adminpb.CreateTableRequest{
+		Parent:  instanceName,
+		TableId: tableID,
+		Table: &adminpb.Table{
+			ColumnFamilies: map[string]*adminpb.ColumnFamily{},
+		},
+	}
+	for _, family := range families {
+		req.Table.ColumnFamilies[family] = &adminpb.ColumnFamily{}
+	}
+	return req
+}
+
+// TestCreateTable_Generic_Headers tests that CreateTable request has client and resource info in
+// the header.
+func TestCreateTable_Generic_Headers(t *testing.T) {
+	// 1. Instantiate the mock server
+	// The server records the metadata of every request, so the mock function only responds
+	server := initMockServer(t)
+	server.CreateTableFn = func(ctx context.Context, req *adminpb.CreateTableRequest) (*adminpb.Table, error) {
+		return &adminpb.Table{Name: req.GetParent() + "/tables/" + req.GetTableId()}, nil
+	}
+
+	// 2. Build the request to test proxy
+	req := testproxypb.CreateTableRequest{
+		ClientId: t.Name(),
+		Request:  dummyCreateTableRequest("table", "f"),
+	}
+
+	// 3. Perform the operation via test proxy
+	doTableAdminOp(t, server, &req, nil, testProxyClient.CreateTable)
+
+	// 4. Check the request headers in the metadata
+	md := requireCallRecords(t, server, "CreateTable", "", 1)[0].md
+	if len(md["user-agent"]) == 0 && len(md["x-goog-api-client"]) == 0 {
+		assert.Fail(t, "Client info is missing in the request header")
+	}
+
+	if !assert.NotEmpty(t, md["x-goog-request-params"], "Resource info is missing in the request header") {
+		return
+	}
+	resource := md["x-goog-request-params"][0]
+	if !strings.Contains(resource, instanceName) && !strings.Contains(resource, url.QueryEscape(instanceName)) {
+		assert.Fail(t, "Resource info is missing in the request header")
+	}
+}
+
+// TestCreateTable_NoRetry_Success tests that client can create a table with column families.
+func TestCreateTable_NoRetry_Success(t *testing.T) {
+	// 0. Common variables
+	clientReq := dummyCreateTableRequest("table", "f1", "f2")
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *tableAdminReqRecord, 1)
+	server := initMockServer(t)
+	server.CreateTableFn = mockCreateTableFn(recorder, &tableAdminAction{})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.CreateTableRequest{
+		ClientId: t.Name(),
+		Request:  clientReq,
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doTableAdminOp(t, server, &req, nil, testProxyClient.CreateTable)
+
+	// 4. Check that the operation succeeded, and the request has the table and column families
+	checkResultOkStatus(t, res)
+	assert.Equal(t, buildTableName("table"), res.GetTable().GetName())
+	if !assert.Equal(t, 1, len(recorder)) {
+		return
+	}
+	loggedReq := (<-recorder).req.(*adminpb.CreateTableRequest)
+	assert.Equal(t, clientReq.GetParent(), loggedReq.GetParent())
+	assert.Equal(t, clientReq.GetTableId(), loggedReq.GetTableId())
+	assert.ElementsMatch(t, []string{"f1", "f2"}, slices.Collect(maps.Keys(loggedReq.GetTable().GetColumnFamilies())))
+}
+
+// TestCreateTable_NoRetry_TransientError tests that client doesn't retry CreateTable on transient
+// errors, as the operation is not idempotent.
+func TestCreateTable_NoRetry_TransientError(t *testing.T) {
+	// 1. Instantiate the mock server
+	recorder := make(chan *tableAdminReqRecord, 2)
+	server := initMockServer(t)
+	server.CreateTableFn = mockCreateTableFn(recorder,
+		&tableAdminAction{rpcError: codes.Unavailable},
+		&tableAdminAction{})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.CreateTableRequest{
+		ClientId: t.Name(),
+		Request:  dummyCreateTableRequest("table", "f"),
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doTableAdminOp(t, server, &req, nil, testProxyClient.CreateTable)
+
+	// 4. Check that the result has error, and there is no retry
+	assert.NotEmpty(t, res)
+	assert.Equal(t, int32(codes.Unavailable), res.GetStatus().GetCode())
+	assert.Equal(t, 1, len(recorder))
+}
+
+// TestDeleteTable_NoRetry_Success tests that client can delete a table.
+func TestDeleteTable_NoRetry_Success(t *testing.T) {
+	// 0. Common variables
+	tableName := buildTableName("table")
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *tableAdminReqRecord, 1)
+	server := initMockServer(t)
+	server.DeleteTableFn = mockDeleteTableFn(recorder, &tableAdminAction{})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.DeleteTableRequest{
+		ClientId: t.Name(),
+		Request:  &adminpb.DeleteTableRequest{Name: tableName},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doTableAdminOp(t, server, &req, nil, testProxyClient.DeleteTable)
+
+	// 4. Check that the operation succeeded
+	checkResultOkStatus(t, res)
+	if !assert.Equal(t, 1, len(recorder)) {
+		return
+	}
+	loggedReq := (<-recorder).req.(*adminpb.DeleteTableRequest)
+	assert.Equal(t, tableName, loggedReq.GetName())
+}
+
+// TestModifyColumnFamilies_NoRetry_Modifications tests that client can create, update and drop
+// column families in one request.
+func TestModifyColumnFamilies_NoRetry_Modifications(t *testing.T) {
+	// 0. Common variables
+	clientReq := &adminpb.ModifyColumnFamiliesRequest{
+		Name: buildTableName("table"),
+		Modifications: []*adminpb.ModifyColumnFamiliesRequest_Modification{
+			&adminpb.ModifyColumnFamiliesRequest_Modification{
+				Id:  "f1",
+				Mod: &adminpb.ModifyColumnFamiliesRequest_Modification_Create{Create: &adminpb.ColumnFamily{}},
+			},
+			&adminpb.ModifyColumnFamiliesRequest_Modification{
+				Id: "f2",
+				Mod: &adminpb.ModifyColumnFamiliesRequest_Modification_Update{Update: &adminpb.ColumnFamily{
+					GcRule: &adminpb.GcRule{Rule: &adminpb.GcRule_MaxNumVersions{MaxNumVersions: 1}},
+				}},
+			},
+			&adminpb.ModifyColumnFamiliesRequest_Modification{
+				Id:  "f3",
+				Mod: &adminpb.ModifyColumnFamiliesRequest_Modification_Drop{Drop: true},
+			},
+		},
+	}
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *tableAdminReqRecord, 1)
+	server := initMockServer(t)
+	server.ModifyColumnFamiliesFn = mockModifyColumnFamiliesFn(recorder, &tableAdminAction{})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.ModifyColumnFamiliesRequest{
+		ClientId: t.Name(),
+		Request:  clientReq,
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doTableAdminOp(t, server, &req, nil, testProxyClient.ModifyColumnFamilies)
+
+	// 4. Check that the operation succeeded, and the modifications are kept in order
+	checkResultOkStatus(t, res)
+	if !assert.Equal(t, 1, len(recorder)) {
+		return
+	}
+	loggedReq := (<-recorder).req.(*adminpb.ModifyColumnFamiliesRequest)
+	if diff := cmp.Diff(clientReq.GetModifications(), loggedReq.GetModifications(), protocmp.Transform(), protocmp.IgnoreEmptyMessages()); diff != "" {
+		t.Errorf("diff found (-want +got):\n%s", diff)
+	}
+}
+
+// TestDropRowRange_NoRetry_RowKeyPrefix tests that client can drop the rows with a row key prefix.
+func TestDropRowRange_NoRetry_RowKeyPrefix(t *testing.T) {
+	// 0. Common variables
+	const prefix string = "row-"
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *tableAdminReqRecord, 1)
+	server := initMockServer(t)
+	server.DropRowRangeFn = mockDropRowRangeFn(recorder, &tableAdminAction{})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.DropRowRangeRequest{
+		ClientId: t.Name(),
+		Request: &adminpb.DropRowRangeRequest{
+			Name:   buildTableName("table"),
+			Target: &adminpb.DropRowRangeRequest_RowKeyPrefix{RowKeyPrefix: []byte(prefix)},
+		},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doTableAdminOp(t, server, &req, nil, testProxyClient.DropRowRange)
+
+	// 4. Check that the operation succeeded with the expected target
+	checkResultOkStatus(t, res)
+	if !assert.Equal(t, 1, len(recorder)) {
+		return
+	}
+	loggedReq := (<-recorder).req.(*adminpb.DropRowRangeRequest)
+	assert.Equal(t, []byte(prefix), loggedReq.GetRowKeyPrefix())
+	assert.False(t, loggedReq.GetDeleteAllDataFromTable())
+}
+
+// TestGenerateConsistencyToken_Retry_TransientError tests that client retries GenerateConsistencyToken
+// on transient errors, as the operation is idempotent.
+func TestGenerateConsistencyToken_Retry_TransientError(t *testing.T) {
+	// 0. Common variables
+	const token string = "token-01"
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *tableAdminReqRecord, 3)
+	server := initMockServer(t)
+	server.GenerateConsistencyTokenFn = mockGenerateConsistencyTokenFn(recorder,
+		&tableAdminAction{rpcError: codes.Unavailable},
+		&tableAdminAction{consistencyToken: token})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.GenerateConsistencyTokenRequest{
+		ClientId: t.Name(),
+		Request:  &adminpb.GenerateConsistencyTokenRequest{Name: buildTableName("table")},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doTableAdminOp(t, server, &req, nil, testProxyClient.GenerateConsistencyToken)
+
+	// 4. Check that the operation succeeded after the retry
+	checkResultOkStatus(t, res)
+	assert.Equal(t, token, res.GetResult().GetConsistencyToken())
+	assert.Equal(t, 2, len(recorder))
+}
+
+// TestCheckConsistency_Retry_TransientError tests that client retries CheckConsistency on transient
+// errors, and passes the consistency token through.
+func TestCheckConsistency_Retry_TransientError(t *testing.T) {
+	// 0. Common variables
+	const token string = "token-01"
+
+	// 1. Instantiate the mock server
+	recorder := make(chan *tableAdminReqRecord, 3)
+	server := initMockServer(t)
+	server.CheckConsistencyFn = mockCheckConsistencyFn(recorder,
+		&tableAdminAction{rpcError: codes.Unavailable},
+		&tableAdminAction{consistent: true})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.CheckConsistencyRequest{
+		ClientId: t.Name(),
+		Request: &adminpb.CheckConsistencyRequest{
+			Name:             buildTableName("table"),
+			ConsistencyToken: token,
+		},
+	}
+
+	// 3. Perform the operation via test proxy
+	res := doTableAdminOp(t, server, &req, nil, testProxyClient.CheckConsistency)
+
+	// 4. Check that the operation succeeded after the retry, and every attempt has the token
+	checkResultOkStatus(t, res)
+	assert.True(t, res.GetResult().GetConsistent())
+	assert.Equal(t, 2, len(recorder))
+	close(recorder)
+	for record := range recorder {
+		assert.Equal(t, token, record.req.(*adminpb.CheckConsistencyRequest).GetConsistencyToken())
+	}
+}
+
+// TestCheckConsistency_Generic_DeadlineExceeded tests that client-side timeout is set and respected
+// by the table admin operations.
+func TestCheckConsistency_Generic_DeadlineExceeded(t *testing.T) {
+	// 1. Instantiate the mock server
+	recorder := make(chan *tableAdminReqRecord, 1)
+	server := initMockServer(t)
+	server.CheckConsistencyFn = mockCheckConsistencyFn(recorder,
+		&tableAdminAction{consistent: true, delayStr: "10s"})
+
+	// 2. Build the request to test proxy
+	req := testproxypb.CheckConsistencyRequest{
+		ClientId: t.Name(),
+		Request: &adminpb.CheckConsistencyRequest{
+			Name:             buildTableName("table"),
+			ConsistencyToken: "token-01",
+		},
+	}
+
+	// 3. Perform the operation via test proxy
+	opts := clientOpts{
+		timeout: &durationpb.Duration{Seconds: 2},
+	}
+	res := doTableAdminOp(t, server, &req, &opts, testProxyClient.CheckConsistency)
+
+	// 4. Check that the operation failed as expected
+	assert.NotEmpty(t, res)
+	assert.Equal(t, int32(codes.DeadlineExceeded), res.GetStatus().GetCode())
+}
@@ -26,11 +26,14 @@ import (
 	"log"
 	"time"
 
+	adminpb "cloud.google.com/go/bigtable/admin/apiv2/adminpb"
 	btpb "cloud.google.com/go/bigtable/apiv2/bigtablepb"
 	"github.com/googleapis/cloud-bigtable-clients-test/testproxypb"
 	"github.com/googleapis/gax-go/v2/apierror"
 	"google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -98,12 +101,26 @@ type chunkData struct {
 //  6. readRowsAction{rpcError: error, retryInfo: delay}
 //     Effect: server will return an error with RetryInfo which has the specific delay.
 //  7. To have a response stream with/without errors, a sequence of actions should be constructed.
+//  8. readRowsAction{numRows: n}
+//     Effect: for the store-backed server only, server will return the next n rows from the store,
+//     and there may be more to come. Zero numRows means all the remaining rows.
+//  9. readRowsAction{rawChunks: chunks}
+//     Effect: server will return the cell chunks as they are, after the chunks built from `chunks`
+//     in the same response. It allows sending the chunks that chunkData can't express, e.g., a
+//     chunk without family name.
+//  10. readRowsAction{rpcError: error, header: md} or readRowsAction{chunks: data, trailer: md}
+//     Effect: server will return the error or the chunks with the response headers or trailers,
+//     e.g., the server-timing and the location that the clients attach to their metrics.
 type readRowsAction struct {
 	chunks        []chunkData
+	rawChunks     []*btpb.ReadRowsResponse_CellChunk
 	rpcError      codes.Code
-	delayStr      string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	delayStr      string      // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header        metadata.MD // Response headers sent with the action, e.g., server-timing.
+	trailer       metadata.MD // Response trailers sent with the action.
 	routingCookie string
 	retryInfo     string // "" means no RetryInfo will be attached in the error status
+	numRows       int    // Only used by the store-backed server, where chunks are ignored.
 }
 
 func (a *readRowsAction) Validate() {
@@ -139,7 +156,9 @@ type sampleRowKeysAction struct {
 	offsetBytes   int64
 	endOfStream   bool // If true, server will conclude the serving stream for the request.
 	rpcError      codes.Code
-	delayStr      string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	delayStr      string      // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header        metadata.MD // Response headers sent with the action, e.g., server-timing.
+	trailer       metadata.MD // Response trailers sent with the action.
 	routingCookie string
 	retryInfo     string // "" means no RetryInfo will be attached in the error status
 }
@@ -157,8 +176,12 @@ type sampleRowKeysAction struct {
 //     Effect: server will return an error after delay.
 //  5. To have a successful mutation after transient errors, a sequence of actions should be constructed.
 type mutateRowAction struct {
-	rpcError codes.Code
-	delayStr string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	rpcError      codes.Code
+	delayStr      string      // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header        metadata.MD // Response headers sent with the action, e.g., server-timing.
+	trailer       metadata.MD // Response trailers sent with the action.
+	routingCookie string
+	retryInfo     string // "" means no RetryInfo will be attached in the error status
 }
 
 func (a *mutateRowAction) Validate() {}
@@ -190,15 +213,21 @@ type entryData struct {
 //     Effect: server will return an error with the cookie. Retry attempt header should have this cookie.
 //  8. mutateRowsAction{rpcError: error, retryInfo: delay}
 //     Effect: server will return an error with RetryInfo which has the specific delay.
-//  9. To have a response stream with/without rpc errors, a sequence of actions should be constructed.
-//  10. "endOfStream = true" is not needed if there are no subsequent actions for a request.
+//  9. mutateRowsAction{data: data, rateLimitInfo: info}
+//     Effect: server will return the current batch of mutation results with RateLimitInfo, which asks
+//     the client to adjust its rate of MutateRows. Empty data returns a response with RateLimitInfo only.
+//  10. To have a response stream with/without rpc errors, a sequence of actions should be constructed.
+//  11. "endOfStream = true" is not needed if there are no subsequent actions for a request.
 type mutateRowsAction struct {
 	data          entryData
-	endOfStream   bool       // If set, server will conclude the serving for the request.
-	rpcError      codes.Code // The error is not specific to a particular row (we use entryData instead).
-	delayStr      string     // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	endOfStream   bool        // If set, server will conclude the serving for the request.
+	rpcError      codes.Code  // The error is not specific to a particular row (we use entryData instead).
+	delayStr      string      // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header        metadata.MD // Response headers sent with the action, e.g., server-timing.
+	trailer       metadata.MD // Response trailers sent with the action.
 	routingCookie string
-	retryInfo     string // "" means no RetryInfo will be attached in the error status
+	retryInfo     string              // "" means no RetryInfo will be attached in the error status
+	rateLimitInfo *btpb.RateLimitInfo // nil means no RateLimitInfo will be attached in the response
 }
 
 func (a *mutateRowsAction) Validate() {}
@@ -218,7 +247,11 @@ func (a *mutateRowsAction) Validate() {}
 type checkAndMutateRowAction struct {
 	predicateMatched bool
 	rpcError         codes.Code
-	delayStr         string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	delayStr         string      // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header           metadata.MD // Response headers sent with the action, e.g., server-timing.
+	trailer          metadata.MD // Response trailers sent with the action.
+	routingCookie    string
+	retryInfo        string // "" means no RetryInfo will be attached in the error status
 }
 
 func (a *checkAndMutateRowAction) Validate() {}
@@ -236,9 +269,13 @@ func (a *checkAndMutateRowAction) Validate() {}
 //     Effect: server will return an error after delay. Any specified row in the same action will be ignored.
 //  5. To have a successful action after transient errors, a sequence of actions should be constructed.
 type readModifyWriteRowAction struct {
-	row      *btpb.Row
-	rpcError codes.Code
-	delayStr string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	row           *btpb.Row
+	rpcError      codes.Code
+	delayStr      string      // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header        metadata.MD // Response headers sent with the action, e.g., server-timing.
+	trailer       metadata.MD // Response trailers sent with the action.
+	routingCookie string
+	retryInfo     string // "" means no RetryInfo will be attached in the error status
 }
 
 func (a *readModifyWriteRowAction) Validate() {}
@@ -263,6 +300,8 @@ type executeQueryAction struct {
 	rpcError      codes.Code
 	apiError      *apierror.APIError // Functions the same as rpcError but allows for more customization
 	delayStr      string             // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header        metadata.MD        // Response headers sent with the action, e.g., server-timing.
+	trailer       metadata.MD        // Response trailers sent with the action.
 	routingCookie string
 	retryInfo     string // "" means no RetryInfo will be attached in the error status
 	endOfStream   bool   // If true, server will conclude the serving stream for the request.
@@ -283,11 +322,121 @@ func (a *executeQueryAction) Validate() {}
 type prepareQueryAction struct {
 	response *btpb.PrepareQueryResponse
 	rpcError codes.Code
-	delayStr string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	delayStr string      // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header   metadata.MD // Response headers sent with the action, e.g., server-timing.
+	trailer  metadata.MD // Response trailers sent with the action.
 }
 
 func (a *prepareQueryAction) Validate() {}
 
+// readChangeStreamAction denotes an error or a response in the response stream for a
+// ReadChangeStream request.
+// Usage:
+//  1. readChangeStreamAction{dataChange: change}
+//     Effect: server will return the data change, and there may be more to come. A mutation with
+//     chunked values can be split into several data changes, where only the last one is done.
+//  2. readChangeStreamAction{heartbeat: heartbeat}
+//     Effect: server will return the heartbeat, and there may be more to come.
+//  3. readChangeStreamAction{closeStream: closeStream}
+//     Effect: server will return the CloseStream message and conclude the serving stream. The
+//     message may carry continuation tokens and new partitions for partition splits and merges.
+//  4. Any of the above with delayStr: the response is returned after delay.
+//  5. readChangeStreamAction{rpcError: error}
+//     Effect: server will return an error. The responses specified in the same action will be ignored.
+//  6. readChangeStreamAction{rpcError: error, retryInfo: delay}
+//     Effect: server will return an error with RetryInfo which has the specific delay.
+//  7. readChangeStreamAction{endOfStream: true}
+//     Effect: server will conclude the serving stream without CloseStream message.
+//  8. To have a response stream with/without errors, a sequence of actions should be constructed.
+type readChangeStreamAction struct {
+	dataChange  *btpb.ReadChangeStreamResponse_DataChange
+	heartbeat   *btpb.ReadChangeStreamResponse_Heartbeat
+	closeStream *btpb.ReadChangeStreamResponse_CloseStream
+	endOfStream bool // If true, server will conclude the serving stream for the request.
+	rpcError    codes.Code
+	delayStr    string      // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	header      metadata.MD // Response headers sent with the action, e.g., server-timing.
+	trailer     metadata.MD // Response trailers sent with the action.
+	retryInfo   string      // "" means no RetryInfo will be attached in the error status
+}
+
+func (a *readChangeStreamAction) Validate() {
+	numRecords := 0
+	for _, set := range []bool{a.dataChange != nil, a.heartbeat != nil, a.closeStream != nil} {
+		if set {
+			numRecords++
+		}
+	}
+	if numRecords > 1 {
+		log.Fatal("Only one of dataChange, heartbeat and closeStream can be set in an action")
+	}
+}
+
+// generateInitialChangeStreamPartitionsAction denotes an error or a response in the response
+// stream for a GenerateInitialChangeStreamPartitions request.
+// Usage:
+//  1. generateInitialChangeStreamPartitionsAction{partitions: partitions}
+//     Effect: server will return the partitions and conclude the serving stream.
+//  2. generateInitialChangeStreamPartitionsAction{rpcError: error}
+//     Effect: server will return an error. partitions specified in the same action will be ignored.
+//  3. Any of the above with delayStr: the action is performed after delay.
+type generateInitialChangeStreamPartitionsAction struct {
+	partitions []*btpb.StreamPartition
+	rpcError   codes.Code
+	delayStr   string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+}
+
+func (a *generateInitialChangeStreamPartitionsAction) Validate() {}
+
+// pingAndWarmAction tells the mock server how to respond to a PingAndWarm request, which clients
+// send to prime their channels. There is no response stream, so server will conclude serving after
+// performing an action.
+// Usage:
+//  1. pingAndWarmAction{}
+//     Effect: server will return a successful response.
+//  2. pingAndWarmAction{rpcError: error}
+//     Effect: server will return an error.
+//  3. Any of the above with delayStr: the action is performed after delay.
+//  4. As a client may prime its channels more than once, server will keep succeeding after the
+//     action sequence is used up.
+type pingAndWarmAction struct {
+	rpcError codes.Code
+	delayStr string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+}
+
+func (a *pingAndWarmAction) Validate() {}
+
+// tableAdminAction tells the mock server how to respond to a table admin request, i.e.,
+// CreateTable, DeleteTable, ModifyColumnFamilies, DropRowRange, GenerateConsistencyToken or
+// CheckConsistency. There is no response stream, so server will conclude serving after performing
+// an action.
+// Usage:
+//  1. tableAdminAction{}
+//     Effect: server will perform the operation successfully. CreateTable and ModifyColumnFamilies
+//     will return a table built from the request.
+//  2. tableAdminAction{table: table}
+//     Effect: CreateTable and ModifyColumnFamilies will return the table.
+//  3. tableAdminAction{consistencyToken: token}
+//     Effect: GenerateConsistencyToken will return the token.
+//  4. tableAdminAction{consistent: result}
+//     Effect: CheckConsistency will return the consistency result.
+//  5. tableAdminAction{rpcError: error}
+//     Effect: server will return an error. The other fields in the same action will be ignored.
+//  6. tableAdminAction{rpcError: error, retryInfo: delay}
+//     Effect: server will return an error with RetryInfo which has the specific delay.
+//  7. Any of the above with delayStr: the action is performed after delay.
+//  8. To have a successful action after transient errors, a sequence of actions should be constructed.
+type tableAdminAction struct {
+	table            *adminpb.Table
+	consistencyToken string
+	consistent       bool
+	rpcError         codes.Code
+	delayStr         string // "" means zero delay; follow https://pkg.go.dev/time#ParseDuration otherwise
+	retryInfo        string // "" means no RetryInfo will be attached in the error status
+}
+
+func (a *tableAdminAction) Validate() {}
+
 // readRowsReqRecord allows the mock server to record the received ReadRowsRequest with timestamp.
 type readRowsReqRecord struct {
 	req *btpb.ReadRowsRequest
@@ -352,11 +501,48 @@ type prepareQueryReqRecord struct {
 
 func (r *prepareQueryReqRecord) GetTs() time.Time { return r.ts }
 
+// readChangeStreamReqRecord allows the mock server to record the received ReadChangeStreamRequest with timestamp.
+type readChangeStreamReqRecord struct {
+	req *btpb.ReadChangeStreamRequest
+	ts  time.Time
+}
+
+func (r *readChangeStreamReqRecord) GetTs() time.Time { return r.ts }
+
+// generateInitialChangeStreamPartitionsReqRecord allows the mock server to record the received
+// GenerateInitialChangeStreamPartitionsRequest with timestamp.
+type generateInitialChangeStreamPartitionsReqRecord struct {
+	req *btpb.GenerateInitialChangeStreamPartitionsRequest
+	ts  time.Time
+}
+
+func (r *generateInitialChangeStreamPartitionsReqRecord) GetTs() time.Time { return r.ts }
+
+// pingAndWarmReqRecord allows the mock server to record the received PingAndWarmRequest with timestamp.
+type pingAndWarmReqRecord struct {
+	req *btpb.PingAndWarmRequest
+	ts  time.Time
+}
+
+func (r *pingAndWarmReqRecord) GetTs() time.Time { return r.ts }
+
+// tableAdminReqRecord allows the mock server to record the received table admin request with
+// timestamp. `req` has the request type of the method, e.g., *adminpb.CreateTableRequest.
+type tableAdminReqRecord struct {
+	req proto.Message
+	ts  time.Time
+}
+
+func (r *tableAdminReqRecord) GetTs() time.Time { return r.ts }
+
 // anyRequest is an interface type that works for the request types of test proxy.
 type anyRequest interface {
 	*testproxypb.ReadRowRequest | *testproxypb.ReadRowsRequest | *testproxypb.MutateRowRequest |
 		*testproxypb.MutateRowsRequest | *testproxypb.SampleRowKeysRequest |
-		*testproxypb.CheckAndMutateRowRequest | *testproxypb.ReadModifyWriteRowRequest | *testproxypb.ExecuteQueryRequest
+		*testproxypb.CheckAndMutateRowRequest | *testproxypb.ReadModifyWriteRowRequest | *testproxypb.ExecuteQueryRequest |
+		*testproxypb.CreateTableRequest | *testproxypb.DeleteTableRequest | *testproxypb.ModifyColumnFamiliesRequest |
+		*testproxypb.DropRowRangeRequest | *testproxypb.GenerateConsistencyTokenRequest | *testproxypb.CheckConsistencyRequest |
+		*testproxypb.ReadChangeStreamRequest
 	GetClientId() string
 }
 
@@ -364,27 +550,36 @@ type anyRequest interface {
 type anyResult interface {
 	*testproxypb.RowResult | *testproxypb.RowsResult | *testproxypb.MutateRowResult |
 		*testproxypb.MutateRowsResult | *testproxypb.SampleRowKeysResult |
-		*testproxypb.CheckAndMutateRowResult | *testproxypb.ExecuteQueryResult
+		*testproxypb.CheckAndMutateRowResult | *testproxypb.ExecuteQueryResult |
+		*testproxypb.TableResult | *testproxypb.DeleteTableResult | *testproxypb.DropRowRangeResult |
+		*testproxypb.GenerateConsistencyTokenResult | *testproxypb.CheckConsistencyResult |
+		*testproxypb.ReadChangeStreamResult | *testproxypb.AddBatcherEntriesResult | *testproxypb.BatcherResult
 	GetStatus() *status.Status
 }
 
 // anyRecord is an interface type that works for the record types defined above.
 type anyRecord interface {
 	*readRowsReqRecord | *sampleRowKeysReqRecord | *mutateRowReqRecord | *mutateRowsReqRecord |
-		*checkAndMutateRowReqRecord | *readModifyWriteRowReqRecord | *executeQueryReqRecord | *prepareQueryReqRecord
+		*checkAndMutateRowReqRecord | *readModifyWriteRowReqRecord | *executeQueryReqRecord | *prepareQueryReqRecord |
+		*tableAdminReqRecord | *readChangeStreamReqRecord | *generateInitialChangeStreamPartitionsReqRecord |
+		*pingAndWarmReqRecord
 	GetTs() time.Time
 }
 
 // anyAction is an interface type that works for the action types of mock server, except for sampleRowKeysAction.
 type anyAction interface {
 	*readRowsAction | *mutateRowAction | *mutateRowsAction |
-		*checkAndMutateRowAction | *readModifyWriteRowAction | *executeQueryAction | *prepareQueryAction
+		*checkAndMutateRowAction | *readModifyWriteRowAction | *executeQueryAction | *prepareQueryAction |
+		*tableAdminAction | *readChangeStreamAction | *generateInitialChangeStreamPartitionsAction |
+		*pingAndWarmAction
 	Validate()
 }
 
 // clientOpts contains the custom settings of app profile id and timeout, which are used
 // when creating a client object in the test proxy.
 type clientOpts struct {
-	profile string
-	timeout *durationpb.Duration
+	profile  string
+	timeout  *durationpb.Duration
+	security *testproxypb.CreateClientRequest_SecurityOptions
+	metrics  bool // Whether the client exports its metrics to the mock server.
 }
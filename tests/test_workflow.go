@@ -19,6 +19,8 @@ import (
 	"encoding/base64"
 	"fmt"
 	"math"
+	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -28,6 +30,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/proto"
 )
@@ -50,6 +53,7 @@ func buildTableName(tableID string) string {
 // and (or) app profile. Creation error will cause the test to fail immediately (e.g.,
 // client ID collision).
 func createCbtClient(t *testing.T, clientID string, serverAddr string, opts *clientOpts) {
+	watchProxy(t)
 	req := testproxypb.CreateClientRequest{
 		ClientId:   clientID,
 		DataTarget: serverAddr,
@@ -59,12 +63,16 @@ func createCbtClient(t *testing.T, clientID string, serverAddr string, opts *cli
 	if opts != nil {
 		req.AppProfileId = opts.profile
 		req.PerOperationTimeout = opts.timeout
+		req.SecurityOptions = opts.security
+		if opts.metrics {
+			req.MetricsTarget = serverAddr
+		}
 	}
 	if *enableFeaturesAll {
 		req.OptionalFeatureConfig = testproxypb.OptionalFeatureConfig_OPTIONAL_FEATURE_CONFIG_ENABLE_ALL
 	}
 
-	_, err := testProxyClient.CreateClient(context.Background(), &req)
+	_, err := proxyClient(t).CreateClient(context.Background(), &req)
 	if err != nil {
 		t.Fatalf("cbt client creation failed: %v", err)
 	}
@@ -74,7 +82,7 @@ func createCbtClient(t *testing.T, clientID string, serverAddr string, opts *cli
 // cause the test to fail immediately (e.g., there is a bug in the proxy).
 func closeCbtClient(t *testing.T, clientID string) {
 	req := testproxypb.CloseClientRequest{ClientId: clientID}
-	_, err := testProxyClient.CloseClient(context.Background(), &req)
+	_, err := proxyClient(t).CloseClient(context.Background(), &req)
 
 	// TODO: should ignore the possible error due to re-closing the client, as some test may
 	// invoke client closing twice.
@@ -87,7 +95,7 @@ func closeCbtClient(t *testing.T, clientID string) {
 // Any failure here will cause the test to fail immediately (e.g., there is a bug in the proxy).
 func removeCbtClient(t *testing.T, clientID string) {
 	req := testproxypb.RemoveClientRequest{ClientId: clientID}
-	_, err := testProxyClient.RemoveClient(context.Background(), &req)
+	_, err := proxyClient(t).RemoveClient(context.Background(), &req)
 
 	if err != nil {
 		t.Fatalf("cbt client removal failed: %v", err)
@@ -101,9 +109,129 @@ func initMockServer(t *testing.T, serverOpt ...grpc.ServerOption) *Server {
 	if err != nil {
 		t.Fatalf("Server initialization failed: %v", err)
 	}
+	setUpTraffic(t, s)
 	return s
 }
 
+// trafficFileCounts tracks the number of recording files per test, as a test may use several
+// mock servers.
+var (
+	trafficFileMu     sync.Mutex
+	trafficFileCounts = map[string]int{}
+)
+
+// trafficFileName returns the name of the recording file for the next mock server of the test.
+// With several test proxies, the name of the proxy that the test runs against is appended, so that
+// the passes don't overwrite the recordings of each other.
+func trafficFileName(t *testing.T) string {
+	trafficFileMu.Lock()
+	defer trafficFileMu.Unlock()
+	name := strings.ReplaceAll(t.Name(), "/", "_")
+	if proxy := multiProxyName(t); proxy != "" {
+		name = name + "@" + strings.NewReplacer("/", "_", ":", "_").Replace(proxy)
+	}
+	key := name
+	if n := trafficFileCounts[key]; n > 0 {
+		name = fmt.Sprintf("%s_%d", name, n)
+	}
+	trafficFileCounts[key]++
+	return name + ".json"
+}
+
+// setUpTraffic makes the mock server `s` record or replay its calls if -record_dir or -replay_dir
+// is set. The recording is saved, or the mismatches against the recording are reported, when the
+// test finishes.
+func setUpTraffic(t *testing.T, s *Server) {
+	switch {
+	case *recordDir != "":
+		file := filepath.Join(*recordDir, trafficFileName(t))
+		s.recordTraffic(t.Name())
+		t.Cleanup(func() {
+			if err := s.saveTraffic(file); err != nil {
+				t.Errorf("Failed to save the recording: %v", err)
+			}
+		})
+	case *replayDir != "":
+		file := filepath.Join(*replayDir, trafficFileName(t))
+		if err := s.replayTraffic(file); err != nil {
+			t.Fatalf("Failed to load the recording: %v", err)
+		}
+		t.Cleanup(func() {
+			for _, mismatch := range s.trafficMismatches() {
+				t.Errorf("Replay mismatch: %s", mismatch)
+			}
+		})
+	}
+}
+
+// skipIfReplaying skips the test in replay mode, as it can't match the recording for `reason`.
+func skipIfReplaying(t *testing.T, reason string) {
+	if *replayDir != "" {
+		t.Skipf("The test can't be replayed, as %s", reason)
+	}
+}
+
+// initMockServerWithStore initializes a mock server whose data methods are served from an
+// in-memory store, without starting it. The store has a table with ID `tableID` and column
+// families `families`. To inject errors and delays on top of the stored data, you can replace the
+// mock functions with the ones built by mock<Method>FnWithStore() with `s.store`. ExecuteQuery and
+// PrepareQuery are not backed by the store.
+func initMockServerWithStore(t *testing.T, tableID string, families []string, serverOpt ...grpc.ServerOption) *Server {
+	s := initMockServer(t, serverOpt...)
+	store, err := newTableStore()
+	if err != nil {
+		t.Fatalf("Store initialization failed: %v", err)
+	}
+	s.store = store
+	if err := store.createTable(tableID, families...); err != nil {
+		s.Close()
+		t.Fatalf("Table creation in the store failed: %v", err)
+	}
+
+	s.ReadRowsFn = mockReadRowsFnWithStore(store, nil)
+	s.SampleRowKeysFn = mockSampleRowKeysFnWithStore(store, nil, nil)
+	s.MutateRowFn = mockMutateRowFnWithStore(store, nil)
+	s.MutateRowsFn = mockMutateRowsFnWithStore(store, nil)
+	s.CheckAndMutateRowFn = mockCheckAndMutateRowFnWithStore(store, nil)
+	s.ReadModifyWriteRowFn = mockReadModifyWriteRowFnWithStore(store, nil)
+	return s
+}
+
+// initMockServerWithTLS initializes a mock server that only accepts TLS connections, without
+// starting it. The server certificate is valid for `certHosts` (DNS names or IP addresses), or for
+// the local host if `certHosts` is empty. It's issued by a freshly generated CA, whose PEM encoding
+// is kept in `s.rootCertsPEM` for the client to trust (see tlsClientOpts()).
+func initMockServerWithTLS(t *testing.T, certHosts []string, serverOpt ...grpc.ServerOption) *Server {
+	if len(certHosts) == 0 {
+		certHosts = []string{"localhost", "127.0.0.1", "::1"}
+	}
+	ca, err := newTestCA()
+	if err != nil {
+		t.Fatalf("CA generation failed: %v", err)
+	}
+	cert, err := ca.issueServerCert(certHosts...)
+	if err != nil {
+		t.Fatalf("Server certificate generation failed: %v", err)
+	}
+	creds := credentials.NewServerTLSFromCert(&cert)
+
+	s := initMockServer(t, append(serverOpt, grpc.Creds(creds))...)
+	s.rootCertsPEM = ca.certPEM
+	return s
+}
+
+// tlsClientOpts returns the client settings for connecting to the TLS-enabled mock server `s`.
+// Non-empty `endpointOverride` will be used as the host name to verify the server certificate.
+func tlsClientOpts(s *Server, endpointOverride string) *clientOpts {
+	return &clientOpts{
+		security: &testproxypb.CreateClientRequest_SecurityOptions{
+			UseSsl:              true,
+			SslEndpointOverride: endpointOverride,
+			SslRootCertsPem:     s.rootCertsPEM,
+		},
+	}
+}
+
 // setUp starts the mock server and creates its accompanying client object.
 func setUp(t *testing.T, s *Server, clientID string, opts *clientOpts) {
 	s.Start()
@@ -189,7 +317,7 @@ func doReadRowOpsCore(
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			res, err := testProxyClient.ReadRow(context.Background(), reqs[i])
+			res, err := proxyClient(t).ReadRow(context.Background(), reqs[i])
 			fillResults(t, results, res, err, i)
 		}(i)
 	}
@@ -254,7 +382,7 @@ func doReadRowsOpsCore(
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			res, err := testProxyClient.ReadRows(context.Background(), reqs[i])
+			res, err := proxyClient(t).ReadRows(context.Background(), reqs[i])
 			fillResults(t, results, res, err, i)
 		}(i)
 	}
@@ -319,7 +447,7 @@ func doMutateRowOpsCore(
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			res, err := testProxyClient.MutateRow(context.Background(), reqs[i])
+			res, err := proxyClient(t).MutateRow(context.Background(), reqs[i])
 			fillResults(t, results, res, err, i)
 		}(i)
 	}
@@ -384,7 +512,7 @@ func doMutateRowsOpsCore(
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			res, err := testProxyClient.BulkMutateRows(context.Background(), reqs[i])
+			res, err := proxyClient(t).BulkMutateRows(context.Background(), reqs[i])
 			fillResults(t, results, res, err, i)
 		}(i)
 	}
@@ -449,7 +577,7 @@ func doSampleRowKeysOpsCore(
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			res, err := testProxyClient.SampleRowKeys(context.Background(), reqs[i])
+			res, err := proxyClient(t).SampleRowKeys(context.Background(), reqs[i])
 			fillResults(t, results, res, err, i)
 		}(i)
 	}
@@ -514,7 +642,7 @@ func doCheckAndMutateRowOpsCore(
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			res, err := testProxyClient.CheckAndMutateRow(context.Background(), reqs[i])
+			res, err := proxyClient(t).CheckAndMutateRow(context.Background(), reqs[i])
 			fillResults(t, results, res, err, i)
 		}(i)
 	}
@@ -579,7 +707,7 @@ func doReadModifyWriteRowOpsCore(
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			res, err := testProxyClient.ReadModifyWriteRow(context.Background(), reqs[i])
+			res, err := proxyClient(t).ReadModifyWriteRow(context.Background(), reqs[i])
 			fillResults(t, results, res, err, i)
 		}(i)
 	}
@@ -644,7 +772,72 @@ func doExecuteQueryOpsCore(
 		wg.Add(1)
 		go func(i int) {
 			defer wg.Done()
-			res, err := testProxyClient.ExecuteQuery(context.Background(), reqs[i])
+			res, err := proxyClient(t).ExecuteQuery(context.Background(), reqs[i])
+			fillResults(t, results, res, err, i)
+		}(i)
+	}
+	if closeCbtClientAfter != nil {
+		time.Sleep(*closeCbtClientAfter)
+		closeCbtClient(t, clientID)
+	}
+	wg.Wait()
+
+	return results
+}
+
+// doReadChangeStreamOp is a simple wrapper of doReadChangeStreamOps. It's useful when there is only
+// one ReadChangeStream operation to perform. A single result will be returned, where nil value
+// indicates proxy failure (not client's).
+func doReadChangeStreamOp(
+	t *testing.T,
+	s *Server,
+	req *testproxypb.ReadChangeStreamRequest,
+	opts *clientOpts) *testproxypb.ReadChangeStreamResult {
+
+	results := doReadChangeStreamOps(t, s, []*testproxypb.ReadChangeStreamRequest{req}, opts)
+	return results[0]
+}
+
+// doReadChangeStreamOps performs ReadChangeStream operations in parallel, using the test proxy
+// requests `reqs` and the mock server `s`. Non-nil `opts` will override the default client settings
+// including app profile id and timeout. The results will be returned, where the i-th result
+// corresponds to the i-th request. nil element indicates proxy failure (not client's).
+// Note that the function manages the setup and teardown of resources.
+func doReadChangeStreamOps(
+	t *testing.T,
+	s *Server,
+	reqs []*testproxypb.ReadChangeStreamRequest,
+	opts *clientOpts) []*testproxypb.ReadChangeStreamResult {
+
+	clientID := reqs[0].GetClientId()
+	setUp(t, s, clientID, opts)
+	defer tearDown(t, s, clientID)
+
+	return doReadChangeStreamOpsCore(t, clientID, reqs, nil)
+}
+
+// doReadChangeStreamOpsCore does the work of sending concurrent requests to test proxy and
+// collecting the results, where the i-th result corresponds to the i-th request. nil element
+// indicates proxy failure (not client's). Non-nil `closeCbtClientAfter` will trigger Cloud Bigtable
+// client being closed after sending off all the requests (>=1s delay should ensure the requests are
+// already sent off when the client is closed).
+// Note that the function doesn't manage the setup and teardown of resources.
+func doReadChangeStreamOpsCore(
+	t *testing.T,
+	clientID string,
+	reqs []*testproxypb.ReadChangeStreamRequest,
+	closeCbtClientAfter *time.Duration) []*testproxypb.ReadChangeStreamResult {
+
+	validateClientID(t, reqs, clientID)
+
+	// Ask the CBT client to do ReadChangeStream via the test proxy
+	var wg sync.WaitGroup
+	results := make([]*testproxypb.ReadChangeStreamResult, len(reqs))
+	for i := range reqs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := proxyClient(t).ReadChangeStream(context.Background(), reqs[i])
 			fillResults(t, results, res, err, i)
 		}(i)
 	}
@@ -657,6 +850,108 @@ func doExecuteQueryOpsCore(
 	return results
 }
 
+// createBatcher creates a mutation batcher in the test proxy with `req`. Creation error will cause
+// the test to fail immediately (e.g., batcher ID collision).
+func createBatcher(t *testing.T, req *testproxypb.CreateBatcherRequest) {
+	_, err := proxyClient(t).CreateBatcher(context.Background(), req)
+	if err != nil {
+		t.Fatalf("batcher creation failed: %v", err)
+	}
+}
+
+// addBatcherEntries adds `entries` to the batcher with ID `batcherID` of the client with ID
+// `clientID`, and returns once the batcher accepts all of them. A single result will be returned,
+// where nil value indicates proxy failure (not client's).
+func addBatcherEntries(t *testing.T, clientID string, batcherID string, entries []*btpb.MutateRowsRequest_Entry) *testproxypb.AddBatcherEntriesResult {
+	req := testproxypb.AddBatcherEntriesRequest{ClientId: clientID, BatcherId: batcherID, Entries: entries}
+	results := make([]*testproxypb.AddBatcherEntriesResult, 1)
+	res, err := proxyClient(t).AddBatcherEntries(context.Background(), &req)
+	fillResults(t, results, res, err, 0)
+	return results[0]
+}
+
+// flushBatcher flushes the batcher with ID `batcherID` of the client with ID `clientID`, and
+// returns once all the added entries are done. A single result will be returned, where nil value
+// indicates proxy failure (not client's).
+func flushBatcher(t *testing.T, clientID string, batcherID string) *testproxypb.BatcherResult {
+	req := testproxypb.FlushBatcherRequest{ClientId: clientID, BatcherId: batcherID}
+	results := make([]*testproxypb.BatcherResult, 1)
+	res, err := proxyClient(t).FlushBatcher(context.Background(), &req)
+	fillResults(t, results, res, err, 0)
+	return results[0]
+}
+
+// closeBatcher flushes and closes the batcher with ID `batcherID` of the client with ID
+// `clientID`. A single result will be returned, where nil value indicates proxy failure (not
+// client's).
+func closeBatcher(t *testing.T, clientID string, batcherID string) *testproxypb.BatcherResult {
+	req := testproxypb.CloseBatcherRequest{ClientId: clientID, BatcherId: batcherID}
+	results := make([]*testproxypb.BatcherResult, 1)
+	res, err := proxyClient(t).CloseBatcher(context.Background(), &req)
+	fillResults(t, results, res, err, 0)
+	return results[0]
+}
+
+// doBatcherOps creates a mutation batcher with `req` using the mock server `s`, adds each group of
+// `entries` in order, and closes the batcher. Non-nil `opts` will override the default client
+// settings including app profile id and timeout. The results of adding the groups and the result
+// of closing the batcher will be returned, where nil value indicates proxy failure (not client's).
+// Note that the function manages the setup and teardown of resources.
+func doBatcherOps(
+	t *testing.T,
+	s *Server,
+	req *testproxypb.CreateBatcherRequest,
+	entries [][]*btpb.MutateRowsRequest_Entry,
+	opts *clientOpts) ([]*testproxypb.AddBatcherEntriesResult, *testproxypb.BatcherResult) {
+
+	clientID := req.GetClientId()
+	setUp(t, s, clientID, opts)
+	defer tearDown(t, s, clientID)
+
+	createBatcher(t, req)
+	results := make([]*testproxypb.AddBatcherEntriesResult, len(entries))
+	for i, group := range entries {
+		results[i] = addBatcherEntries(t, clientID, req.GetBatcherId(), group)
+	}
+	return results, closeBatcher(t, clientID, req.GetBatcherId())
+}
+
+// doTableAdminOp performs a single table admin operation, using the test proxy request `req` and the
+// mock server `s`. `call` is the test proxy method of the operation, e.g.,
+// testProxyClient.CreateTable. Non-nil `opts` will override the default client settings including
+// app profile id and timeout. A single result will be returned, where nil value indicates proxy
+// failure (not client's).
+// Note that the function manages the setup and teardown of resources.
+func doTableAdminOp[Req anyRequest, Res anyResult](
+	t *testing.T,
+	s *Server,
+	req Req,
+	opts *clientOpts,
+	call func(context.Context, Req, ...grpc.CallOption) (Res, error)) Res {
+
+	clientID := req.GetClientId()
+	setUp(t, s, clientID, opts)
+	defer tearDown(t, s, clientID)
+
+	// Ask the CBT client to do the table admin operation via the test proxy
+	results := make([]Res, 1)
+	res, err := call(context.Background(), req)
+	fillResults(t, results, res, err, 0)
+
+	return results[0]
+}
+
+// requireCallRecords waits until the mock server `s` has received `n` calls of `method` for the
+// operation with ID `opID` ("" matches any), and returns the calls received so far. The test fails
+// immediately if it takes longer than 5 seconds.
+func requireCallRecords(t *testing.T, s *Server, method string, opID string, n int) []*callRecord {
+	records, err := s.waitForCallRecords(method, opID, n, 5*time.Second)
+	if err != nil {
+		t.Fatalf("Calls are missing on the server: %v", err)
+	}
+	return records
+}
+
 // checkResultOkStatus checks if the results have ok status. The result type can be any of those
 // supported by the test proxy.
 func checkResultOkStatus[R anyResult](t *testing.T, results ...R) {
@@ -0,0 +1,124 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// This file defines the checks of the trace context propagation. The test
+// proxy traces an operation as a child of the span in the traceparent field of
+// the request, and the client propagates the trace to every attempt, either in
+// the W3C traceparent header or in the binary grpc-trace-bin header of
+// OpenCensus. The mock server records the trace context of each attempt, see
+// mock_recorder.go.
+package tests
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// traceparentHeader is the W3C trace context, i.e., "<version>-<trace-id>-<span-id>-<flags>".
+	traceparentHeader = "traceparent"
+	// grpcTraceBinHeader is the binary trace context of OpenCensus.
+	grpcTraceBinHeader = "grpc-trace-bin"
+)
+
+// traceContext identifies a span, where the IDs are in lowercase hex.
+type traceContext struct {
+	traceID string
+	spanID  string
+}
+
+// newTraceContext returns a random span, as the parent of an operation sent to the test proxy.
+func newTraceContext() traceContext {
+	ids := make([]byte, 24)
+	rand.Read(ids)
+	return traceContext{traceID: hex.EncodeToString(ids[:16]), spanID: hex.EncodeToString(ids[16:])}
+}
+
+// traceparent returns the W3C traceparent of the span, which is sampled.
+func (tc traceContext) traceparent() string {
+	return fmt.Sprintf("00-%s-%s-01", tc.traceID, tc.spanID)
+}
+
+// parseTraceparent parses the W3C traceparent `value`.
+func parseTraceparent(value string) (traceContext, error) {
+	parts := strings.Split(strings.TrimSpace(value), "-")
+	if len(parts) < 4 || len(parts[1]) != 32 || len(parts[2]) != 16 {
+		return traceContext{}, fmt.Errorf("malformed traceparent %q", value)
+	}
+	for _, id := range parts[1:3] {
+		if _, err := hex.DecodeString(id); err != nil || strings.Trim(id, "0") == "" {
+			return traceContext{}, fmt.Errorf("invalid ID %q in traceparent %q", id, value)
+		}
+	}
+	return traceContext{traceID: strings.ToLower(parts[1]), spanID: strings.ToLower(parts[2])}, nil
+}
+
+// parseGRPCTraceBin parses the grpc-trace-bin `value`, which is version 0 followed by the fields
+// of the trace ID (0), the span ID (1) and the trace options (2), each prefixed by its ID.
+func parseGRPCTraceBin(value []byte) (traceContext, error) {
+	if len(value) < 1+17+9 || value[0] != 0 || value[1] != 0 || value[18] != 1 {
+		return traceContext{}, fmt.Errorf("malformed grpc-trace-bin %x", value)
+	}
+	return traceContext{traceID: hex.EncodeToString(value[2:18]), spanID: hex.EncodeToString(value[19:27])}, nil
+}
+
+// attemptTraceContext returns the trace context that the client propagates in the metadata `md` of
+// an attempt. traceparent takes precedence over grpc-trace-bin if both are sent.
+func attemptTraceContext(md metadata.MD) (traceContext, error) {
+	if values := md.Get(traceparentHeader); len(values) > 0 {
+		return parseTraceparent(values[0])
+	}
+	if values := md.Get(grpcTraceBinHeader); len(values) > 0 {
+		return parseGRPCTraceBin([]byte(values[0]))
+	}
+	return traceContext{}, fmt.Errorf("no %s or %s", traceparentHeader, grpcTraceBinHeader)
+}
+
+// checkAttemptTraces checks the trace context of every attempt of `method` for the operation with
+// ID `opID` ("" matches any) at the mock server `s`, where the operation is sent to the test proxy
+// as a child of `parent`. Every attempt should be in the trace of `parent`, in a span of its own.
+// It returns the span IDs of the attempts.
+func checkAttemptTraces(t *testing.T, s *Server, method string, opID string, parent traceContext) []string {
+	records := s.callRecords(method, opID)
+	if len(records) == 0 {
+		t.Errorf("No %s attempt to check the trace context", method)
+		return nil
+	}
+	var spanIDs []string
+	attempts := make(map[string]int)
+	for i, record := range records {
+		if record.traceErr != nil {
+			t.Errorf("%s attempt %d has no valid trace context: %v", method, i+1, record.traceErr)
+			continue
+		}
+		tc := record.trace
+		if tc.traceID != parent.traceID {
+			t.Errorf("%s attempt %d has trace ID %s, want %s of the operation", method, i+1, tc.traceID, parent.traceID)
+		}
+		if tc.spanID == parent.spanID {
+			t.Errorf("%s attempt %d has the span ID %s of the operation's parent, want its own span", method, i+1, tc.spanID)
+		}
+		if prev, ok := attempts[tc.spanID]; ok {
+			t.Errorf("%s attempt %d has the same span ID %s as attempt %d", method, i+1, tc.spanID, prev)
+		}
+		attempts[tc.spanID] = i + 1
+		spanIDs = append(spanIDs, tc.spanID)
+	}
+	return spanIDs
+}
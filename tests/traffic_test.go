@@ -0,0 +1,71 @@
+// Copyright 2025 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !emulator
+// +build !emulator
+
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestTrafficRoundTrip tests that some of the tests still pass when they replay their own
+// recording, i.e., that the recording keeps the headers, trailers and delays of the responses, the
+// HTTP/2 faults, and the calls that the client abandons on a deadline or when it's closed.
+func TestTrafficRoundTrip(t *testing.T) {
+	if *recordDir != "" || *replayDir != "" {
+		t.Skip("The test records and replays the traffic on its own")
+	}
+
+	tests := []struct {
+		name string
+		run  func(*testing.T)
+	}{
+		{"TestReadRows_Retry_StreamReset", TestReadRows_Retry_StreamReset},
+		{"TestMutateRow_Retry_ServerTimingAndLocation", TestMutateRow_Retry_ServerTimingAndLocation},
+		{"TestMutateRow_Generic_DeadlineExceeded", TestMutateRow_Generic_DeadlineExceeded},
+		{"TestMutateRow_Generic_CloseClient", TestMutateRow_Generic_CloseClient},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := t.TempDir()
+			defer func() { *recordDir, *replayDir = "", "" }()
+
+			// 1. Run the test in recording mode
+			*recordDir = dir
+			if !t.Run("record", test.run) {
+				return
+			}
+			*recordDir = ""
+
+			// 2. Name the recordings after the replaying subtest, and run the test in replay mode
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Fatalf("Failed to list the recordings: %v", err)
+			}
+			for _, entry := range entries {
+				replayName := strings.Replace(entry.Name(), test.name+"_record", test.name+"_replay", 1)
+				if err := os.Rename(filepath.Join(dir, entry.Name()), filepath.Join(dir, replayName)); err != nil {
+					t.Fatalf("Failed to rename the recording: %v", err)
+				}
+			}
+			*replayDir = dir
+			t.Run("replay", test.run)
+		})
+	}
+}